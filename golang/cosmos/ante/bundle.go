@@ -0,0 +1,53 @@
+package ante
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// ErrBundleAlreadyInstalled is returned when a Tx attempts to install a bundle
+// which SwingSet has already accepted.
+var ErrBundleAlreadyInstalled = swingtypes.ErrBundleAlreadyInstalled
+
+// bundleAnte is an sdk.AnteDecorator which rejects re-installs of bundles.
+type bundleAnte struct {
+	sk SwingsetKeeper
+}
+
+// NewBundleDecorator returns an AnteDecorator which rejects Txs installing a
+// bundle already accepted by SwingSet. Identifying a bundle may require
+// uncompressing it, so it must run after fees are deducted, but it should run
+// before admission beans are charged, so that the submitter does not pay for
+// the useless install on top of the Tx fee.
+func NewBundleDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
+	return bundleAnte{sk: sk}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (ba bundleAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		installMsg, ok := msg.(*swingtypes.MsgInstallBundle)
+		if !ok {
+			continue
+		}
+		bundleID, err := installMsg.BundleID()
+		if err != nil {
+			// Let SwingSet reject the bundle.
+			continue
+		}
+		if ba.sk.IsBundleAccepted(ctx, bundleID) {
+			defer telemetry.IncrCounterWithLabels(
+				[]string{"tx", "ante", "bundle_already_installed"},
+				1,
+				[]metrics.Label{},
+			)
+			return ctx, sdkioerrors.Wrapf(ErrBundleAlreadyInstalled, "bundle %s is already installed", bundleID)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,70 @@
+package ante
+
+import (
+	"context"
+	"testing"
+
+	sdkioerrors "cosmossdk.io/errors"
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestBundleAnteHandle(t *testing.T) {
+	const bundle = `{"moduleFormat":"endoZipBase64","endoZipBase64Sha512":"abc123","endoZipBase64":""}`
+	compressed := swingtypes.NewMsgInstallBundle(bundle, nil)
+	if err := compressed.Compress(); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name            string
+		tx              sdk.Tx
+		acceptedBundles map[string]bool
+		wantErr         bool
+	}{
+		{
+			name: "non-bundle",
+			tx:   makeTestTx(&banktypes.MsgSend{}),
+		},
+		{
+			name: "new-bundle",
+			tx:   makeTestTx(swingtypes.NewMsgInstallBundle(bundle, nil)),
+		},
+		{
+			name:            "other-bundle-accepted",
+			tx:              makeTestTx(swingtypes.NewMsgInstallBundle(bundle, nil)),
+			acceptedBundles: map[string]bool{"b1-def456": true},
+		},
+		{
+			name:            "bundle-accepted",
+			tx:              makeTestTx(&banktypes.MsgSend{}, swingtypes.NewMsgInstallBundle(bundle, nil)),
+			acceptedBundles: map[string]bool{"b1-abc123": true},
+			wantErr:         true,
+		},
+		{
+			name:            "compressed-bundle-accepted",
+			tx:              makeTestTx(compressed),
+			acceptedBundles: map[string]bool{"b1-abc123": true},
+			wantErr:         true,
+		},
+		{
+			name:            "not-json",
+			tx:              makeTestTx(swingtypes.NewMsgInstallBundle("foo", nil)),
+			acceptedBundles: map[string]bool{"b1-abc123": true},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithContext(context.Background())
+			mock := mockSwingsetKeeper{acceptedBundles: tt.acceptedBundles}
+			decorator := NewBundleDecorator(mock)
+			_, err := decorator.AnteHandle(ctx, tt.tx, false, nilAnteHandler)
+			if err != nil && !tt.wantErr {
+				t.Errorf("want no error, got %s", err.Error())
+			} else if err == nil && tt.wantErr {
+				t.Errorf("want error, got none")
+			} else if err != nil && !sdkioerrors.IsOf(err, ErrBundleAlreadyInstalled) {
+				t.Errorf("want ErrBundleAlreadyInstalled, got %s", err.Error())
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package ante
 
 import (
+	sdkmath "cosmossdk.io/math"
 	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -22,5 +23,10 @@ type FeegrantKeeper interface {
 
 type SwingsetKeeper interface {
 	InboundQueueLength(ctx sdk.Context) (int32, error)
+	InboundQueueSenderCount(ctx sdk.Context, sender sdk.AccAddress, msgType string) (int32, error)
 	GetState(ctx sdk.Context) swingtypes.State
+	IsBundleAccepted(ctx sdk.Context, bundleID string) bool
+	GetParams(ctx sdk.Context) swingtypes.Params
+	GetBeansPerUnit(ctx sdk.Context) map[string]sdkmath.Uint
+	GetInboundPause(ctx sdk.Context) swingtypes.InboundPause
 }
@@ -0,0 +1,87 @@
+package ante
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// feeDenomTypeURL is the type URL of the swingset fee denom extension option.
+var feeDenomTypeURL = "/" + proto.MessageName(&swingtypes.ExtensionOptionFeeDenom{})
+
+// AcceptFeeDenomExtension is an ante.ExtensionOptionChecker accepting only the
+// swingset ExtensionOptionFeeDenom.
+func AcceptFeeDenomExtension(any *codectypes.Any) bool {
+	return any.TypeUrl == feeDenomTypeURL
+}
+
+// feeDenomAnte is an sdk.AnteDecorator which records the denom chosen to
+// settle the bean charges of a Tx.
+type feeDenomAnte struct {
+	sk SwingsetKeeper
+}
+
+// NewFeeDenomDecorator returns an AnteDecorator which records with
+// vm.WithFeeDenom the denom of the fee unit price in which the bean charges of
+// the Tx are settled. The denom is chosen by an ExtensionOptionFeeDenom, which
+// is rejected if the denom is not accepted, or else is the first denom of the
+// Tx fee which is accepted, if any.
+func NewFeeDenomDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
+	return feeDenomAnte{sk: sk}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+// Txs without vm.ControllerAdmissionMsgs are not charged beans, and are passed
+// through without consulting the Swingset params.
+func (fa feeDenomAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	hasAdmissionMsg := false
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(vm.ControllerAdmissionMsg); ok {
+			hasAdmissionMsg = true
+			break
+		}
+	}
+	if !hasAdmissionMsg {
+		return next(ctx, tx, simulate)
+	}
+
+	var params *swingtypes.Params
+	getParams := func() swingtypes.Params {
+		if params == nil {
+			p := fa.sk.GetParams(ctx)
+			params = &p
+		}
+		return *params
+	}
+
+	if extTx, ok := tx.(ante.HasExtensionOptionsTx); ok {
+		for _, any := range append(extTx.GetExtensionOptions(), extTx.GetNonCriticalExtensionOptions()...) {
+			if !AcceptFeeDenomExtension(any) {
+				continue
+			}
+			var opt swingtypes.ExtensionOptionFeeDenom
+			if err := proto.Unmarshal(any.Value, &opt); err != nil {
+				return ctx, sdkioerrors.Wrapf(sdkerrors.ErrTxDecode, "invalid fee denom extension: %s", err)
+			}
+			if _, ok := getParams().FeeUnitPriceIn(opt.Denom); !ok || opt.Denom == "" {
+				return ctx, sdkioerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee denom %q is not accepted for bean charges", opt.Denom)
+			}
+			return next(vm.WithFeeDenom(ctx, opt.Denom), tx, simulate)
+		}
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		for _, coin := range feeTx.GetFee() {
+			if _, ok := getParams().FeeUnitPriceIn(coin.Denom); ok {
+				return next(vm.WithFeeDenom(ctx, coin.Denom), tx, simulate)
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
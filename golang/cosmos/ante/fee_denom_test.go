@@ -0,0 +1,100 @@
+package ante
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// extensionTx exposes the extension options of a tx.Tx like the auth module's
+// Tx wrapper.
+type extensionTx struct {
+	*tx.Tx
+}
+
+func (et extensionTx) GetExtensionOptions() []*codectypes.Any {
+	return et.Body.ExtensionOptions
+}
+
+func (et extensionTx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	return et.Body.NonCriticalExtensionOptions
+}
+
+func withFeeDenomExtension(t sdk.Tx, denom string) sdk.Tx {
+	ext, err := codectypes.NewAnyWithValue(&swingtypes.ExtensionOptionFeeDenom{Denom: denom})
+	if err != nil {
+		panic(err)
+	}
+	t.(*tx.Tx).Body.ExtensionOptions = []*codectypes.Any{ext}
+	return extensionTx{t.(*tx.Tx)}
+}
+
+func TestFeeDenomAnteHandle(t *testing.T) {
+	params := swingtypes.DefaultParams()
+	params.FeeUnitPrice = sdk.NewCoins(sdk.NewInt64Coin("ubld", 2_000_000), sdk.NewInt64Coin("uist", 1_000_000))
+	fee := func(denom string) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, 10_000))
+	}
+	for _, tt := range []struct {
+		name      string
+		tx        sdk.Tx
+		wantDenom string
+		wantErr   bool
+	}{
+		{
+			name: "non-swingset",
+			tx:   withFeeDenomExtension(makeTestFeeTx(fee("uist"), &banktypes.MsgSend{}), "ubld"),
+		},
+		{
+			name:      "fee-denom",
+			tx:        makeTestFeeTx(fee("uist"), &swingtypes.MsgWalletAction{}),
+			wantDenom: "uist",
+		},
+		{
+			name: "fee-denom-not-accepted",
+			tx:   makeTestFeeTx(fee("uatom"), &swingtypes.MsgWalletAction{}),
+		},
+		{
+			name:      "extension",
+			tx:        withFeeDenomExtension(makeTestFeeTx(fee("uist"), &swingtypes.MsgWalletAction{}), "ubld"),
+			wantDenom: "ubld",
+		},
+		{
+			name:    "extension-not-accepted",
+			tx:      withFeeDenomExtension(makeTestFeeTx(fee("uist"), &swingtypes.MsgWalletAction{}), "uatom"),
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithContext(context.Background())
+			mock := mockSwingsetKeeper{params: &params}
+			decorator := NewFeeDenomDecorator(mock)
+			var gotDenom string
+			_, err := decorator.AnteHandle(ctx, tt.tx, false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				gotDenom = vm.FeeDenom(ctx)
+				return ctx, nil
+			})
+			if err != nil && !tt.wantErr {
+				t.Errorf("want no error, got %s", err.Error())
+			} else if err == nil && tt.wantErr {
+				t.Errorf("want error, got none")
+			}
+			if gotDenom != tt.wantDenom {
+				t.Errorf("got fee denom %q, want %q", gotDenom, tt.wantDenom)
+			}
+		})
+	}
+
+	if !AcceptFeeDenomExtension(&codectypes.Any{TypeUrl: "/agoric.swingset.ExtensionOptionFeeDenom"}) {
+		t.Errorf("fee denom extension not accepted")
+	}
+	if AcceptFeeDenomExtension(&codectypes.Any{TypeUrl: "/agoric.swingset.MsgWalletAction"}) {
+		t.Errorf("other extension accepted")
+	}
+}
@@ -1,6 +1,7 @@
 package ante
 
 import (
+	sdkioerrors "cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -32,15 +33,25 @@ the number of messages allowed for mempool admission as if its max
 queue length was lower (e.g. 50%). This is the QueueInboundMempool
 entry in the Swingset state QueueAllowed field. At DeliverTx time
 the QueueInbound entry gives the number of allowed messages.
-*/
 
-const (
-	maxInboundPerTx = 1
-)
+A single Tx may carry several inbound messages, up to the QueueInboundPerTx
+entry of the Swingset state QueueAllowed field (itself taken from the params
+QueueMax). The Tx is rejected as a whole if any of its messages doesn't fit.
+A MsgWalletActionBatch is delivered as a single action, and so counts as a
+single message however many wallet actions it carries.
+
+To keep a single sender from starving everyone else, the QueueInboundPerSender
+entry (and the QueueInboundPerSenderMsgTypePrefix entries for specific message
+types) limit the number of actions from the same sender which may be in the
+actionQueue, counting those of the Tx itself. These limits are reported with
+ErrInboundSenderLimit rather than ErrInboundQueueFull.
+*/
 
 // TODO: We don't have a more appropriate error type for this.
 var ErrInboundQueueFull = sdkerrors.ErrMempoolIsFull
 
+var ErrInboundSenderLimit = swingtypes.ErrInboundSenderLimit
+
 // inboundAnte is an sdk.AnteDecorator which enforces the allowed size of the inbound queue.
 type inboundAnte struct {
 	sk SwingsetKeeper
@@ -57,6 +68,8 @@ func NewInboundDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
 func (ia inboundAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	msgs := tx.GetMsgs()
 	inboundsAllowed := int32(-1)
+	var state swingtypes.State
+	senderInbounds := map[string]int32{}
 	for _, msg := range msgs {
 		inbounds := inboundMessages(msg)
 		if inbounds == 0 {
@@ -64,7 +77,8 @@ func (ia inboundAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next
 		}
 		if inboundsAllowed == -1 {
 			var err error
-			inboundsAllowed, err = ia.allowedInbound(ctx)
+			state = ia.sk.GetState(ctx)
+			inboundsAllowed, err = ia.allowedInbound(ctx, state)
 			if err != nil {
 				return ctx, err
 			}
@@ -89,10 +103,62 @@ func (ia inboundAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next
 			}()
 			return ctx, ErrInboundQueueFull
 		}
+		if !isHighPriority {
+			if err := ia.checkSenderLimits(ctx, state, msg, inbounds, senderInbounds); err != nil {
+				defer func() {
+					telemetry.IncrCounterWithLabels(
+						[]string{"tx", "ante", "inbound_sender_limit"},
+						1,
+						[]metrics.Label{
+							telemetry.NewLabel("msg", sdk.MsgTypeURL(msg)),
+						},
+					)
+				}()
+				return ctx, err
+			}
+		}
 	}
 	return next(ctx, tx, simulate)
 }
 
+// checkSenderLimits returns an error if the inbound messages of msg would
+// exceed the limits on queued actions from its sender, taking into account the
+// senderInbounds of the preceding messages in the Tx, which are updated if the
+// message is allowed.
+func (ia inboundAnte) checkSenderLimits(ctx sdk.Context, state swingtypes.State, msg sdk.Msg, inbounds int32, senderInbounds map[string]int32) error {
+	signers := msg.GetSigners()
+	if len(signers) == 0 {
+		return nil
+	}
+	sender := signers[0]
+	msgType := sdk.MsgTypeURL(msg)
+
+	limitedTypes := make([]string, 0, 2)
+	for _, limitedType := range []string{"", msgType} {
+		entry := swingtypes.QueueInboundPerSender
+		if limitedType != "" {
+			entry = swingtypes.QueueInboundPerSenderMsgTypePrefix + limitedType
+		}
+		limit, found := swingtypes.QueueSizeEntry(state.QueueAllowed, entry)
+		if !found {
+			continue
+		}
+		queued, err := ia.sk.InboundQueueSenderCount(ctx, sender, limitedType)
+		if err != nil {
+			return err
+		}
+		key := sender.String() + "/" + limitedType
+		if int64(queued)+int64(senderInbounds[key])+int64(inbounds) > int64(limit) {
+			return sdkioerrors.Wrapf(ErrInboundSenderLimit, "%s limit %d reached for %s", entry, limit, sender)
+		}
+		limitedTypes = append(limitedTypes, key)
+	}
+	for _, key := range limitedTypes {
+		senderInbounds[key] += inbounds
+	}
+	return nil
+}
+
 func (ia inboundAnte) isPriorityMessage(ctx sdk.Context, msg sdk.Msg) (bool, error) {
 	if c, ok := msg.(vm.ControllerAdmissionMsg); ok {
 		return c.IsHighPriority(ctx, ia.sk)
@@ -103,8 +169,7 @@ func (ia inboundAnte) isPriorityMessage(ctx sdk.Context, msg sdk.Msg) (bool, err
 // allowedInbound returns the allowed number of inbound queue messages or an error.
 // Look up the limit from the swingset state queue sizes: from QueueInboundMempool
 // if we're running CheckTx (for the hysteresis described above), otherwise QueueAllowed.
-func (ia inboundAnte) allowedInbound(ctx sdk.Context) (int32, error) {
-	state := ia.sk.GetState(ctx)
+func (ia inboundAnte) allowedInbound(ctx sdk.Context, state swingtypes.State) (int32, error) {
 	entry := swingtypes.QueueInbound
 	if ctx.IsCheckTx() {
 		entry = swingtypes.QueueInboundMempool
@@ -122,6 +187,13 @@ func (ia inboundAnte) allowedInbound(ctx sdk.Context) (int32, error) {
 		return 0, nil
 	}
 	allowed -= actions
+	maxInboundPerTx, found := swingtypes.QueueSizeEntry(state.QueueAllowed, swingtypes.QueueInboundPerTx)
+	if !found {
+		// preserve the historical limit if not configured
+		maxInboundPerTx = swingtypes.DefaultInboundPerTxMax
+	} else if maxInboundPerTx < 0 {
+		maxInboundPerTx = 0
+	}
 	if allowed > maxInboundPerTx {
 		return maxInboundPerTx, nil
 	}
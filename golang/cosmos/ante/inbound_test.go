@@ -2,6 +2,7 @@ package ante
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -27,6 +28,10 @@ func TestInboundAnteHandle(t *testing.T) {
 		mempoolLimit          int32
 		errMsg                string
 		isHighPriorityOwner   bool
+		inboundPerTx          int32
+		senderQueueAllowed    []swingtypes.QueueSize
+		senderQueued          map[string]int32
+		errIs                 error
 	}{
 		{
 			name: "empty-empty",
@@ -103,6 +108,73 @@ func TestInboundAnteHandle(t *testing.T) {
 			inboundQueueLength: 5,
 			errMsg:             ErrInboundQueueFull.Error(),
 		},
+		{
+			name:               "multi-per-tx",
+			tx:                 makeTestTx(&swingtypes.MsgWalletAction{}, &swingtypes.MsgWalletSpendAction{}, &swingtypes.MsgWalletAction{}),
+			inboundLimit:       10,
+			inboundQueueLength: 5,
+			inboundPerTx:       3,
+		},
+		{
+			name:               "multi-per-tx-over-max",
+			tx:                 makeTestTx(&swingtypes.MsgWalletAction{}, &swingtypes.MsgWalletSpendAction{}, &swingtypes.MsgWalletAction{}),
+			inboundLimit:       10,
+			inboundQueueLength: 5,
+			inboundPerTx:       2,
+			errMsg:             ErrInboundQueueFull.Error(),
+		},
+		{
+			name:               "multi-per-tx-queue-budget",
+			tx:                 makeTestTx(&swingtypes.MsgWalletAction{}, &banktypes.MsgSend{}, &swingtypes.MsgWalletSpendAction{}),
+			inboundLimit:       10,
+			inboundQueueLength: 9,
+			inboundPerTx:       5,
+			errMsg:             ErrInboundQueueFull.Error(),
+		},
+		{
+			name:               "sender-limit",
+			tx:                 makeTestTx(&swingtypes.MsgWalletAction{}, &swingtypes.MsgWalletSpendAction{}),
+			inboundLimit:       10,
+			inboundPerTx:       3,
+			senderQueueAllowed: []swingtypes.QueueSize{swingtypes.NewQueueSize(swingtypes.QueueInboundPerSender, 3)},
+			senderQueued:       map[string]int32{"": 1},
+		},
+		{
+			name:               "sender-limit-exceeded",
+			tx:                 makeTestTx(&swingtypes.MsgWalletAction{}, &swingtypes.MsgWalletSpendAction{}),
+			inboundLimit:       10,
+			inboundPerTx:       3,
+			senderQueueAllowed: []swingtypes.QueueSize{swingtypes.NewQueueSize(swingtypes.QueueInboundPerSender, 2)},
+			senderQueued:       map[string]int32{"": 1},
+			errIs:              ErrInboundSenderLimit,
+		},
+		{
+			name:         "sender-msg-type-limit-exceeded",
+			tx:           makeTestTx(&swingtypes.MsgWalletSpendAction{}, &swingtypes.MsgWalletAction{}, &swingtypes.MsgWalletSpendAction{}),
+			inboundLimit: 10,
+			inboundPerTx: 3,
+			senderQueueAllowed: []swingtypes.QueueSize{
+				swingtypes.NewQueueSize(swingtypes.QueueInboundPerSender, 10),
+				swingtypes.NewQueueSize(swingtypes.QueueInboundPerSenderMsgTypePrefix+"/agoric.swingset.MsgWalletSpendAction", 1),
+			},
+			errIs: ErrInboundSenderLimit,
+		},
+		{
+			name:               "sender-limit-checktx",
+			checkTx:            true,
+			tx:                 makeTestTx(&swingtypes.MsgWalletAction{}),
+			mempoolLimit:       10,
+			senderQueueAllowed: []swingtypes.QueueSize{swingtypes.NewQueueSize(swingtypes.QueueInboundPerSender, 1)},
+			senderQueued:       map[string]int32{"": 1},
+			errIs:              ErrInboundSenderLimit,
+		},
+		{
+			name:                "sender-limit-priority-bypass",
+			tx:                  makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			isHighPriorityOwner: true,
+			senderQueueAllowed:  []swingtypes.QueueSize{swingtypes.NewQueueSize(swingtypes.QueueInboundPerSender, 1)},
+			senderQueued:        map[string]int32{"": 1},
+		},
 		{
 			name:                "priority-limit-bypass",
 			tx:                  makeTestTx(&swingtypes.MsgWalletSpendAction{}),
@@ -126,6 +198,19 @@ func TestInboundAnteHandle(t *testing.T) {
 			isHighPriorityOwner: true,
 			inboundLimit:        1,
 		},
+		{
+			name:               "batch-single-entry",
+			tx:                 makeTestTx(testWalletActionBatch),
+			inboundLimit:       10,
+			inboundQueueLength: 9,
+		},
+		{
+			name:               "batch-no-room",
+			tx:                 makeTestTx(testWalletActionBatch),
+			inboundLimit:       10,
+			inboundQueueLength: 10,
+			errMsg:             ErrInboundQueueFull.Error(),
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := sdk.Context{}.WithContext(context.Background()).WithIsCheckTx(tt.checkTx)
@@ -140,6 +225,9 @@ func TestInboundAnteHandle(t *testing.T) {
 				mempoolLimit:          tt.mempoolLimit,
 				emptyQueueAllowed:     emptyQueueAllowed,
 				isHighPriorityOwner:   tt.isHighPriorityOwner,
+				inboundPerTx:          tt.inboundPerTx,
+				senderQueueAllowed:    tt.senderQueueAllowed,
+				senderQueued:          tt.senderQueued,
 			}
 			decorator := NewInboundDecorator(mock)
 			newCtx, err := decorator.AnteHandle(ctx, tt.tx, tt.simulate, nilAnteHandler)
@@ -147,18 +235,29 @@ func TestInboundAnteHandle(t *testing.T) {
 				t.Errorf("want ctx %v, got %v", ctx, newCtx)
 			}
 			if err != nil {
-				if tt.errMsg == "" {
+				if tt.errIs != nil {
+					if !errors.Is(err, tt.errIs) {
+						t.Errorf("want error %s, got %s", tt.errIs.Error(), err.Error())
+					}
+				} else if tt.errMsg == "" {
 					t.Errorf("want no error, got %s", err.Error())
 				} else if err.Error() != tt.errMsg {
 					t.Errorf("want error %s, got %s", tt.errMsg, err.Error())
 				}
-			} else if tt.errMsg != "" {
+			} else if tt.errMsg != "" || tt.errIs != nil {
 				t.Errorf("want error %s, got none", tt.errMsg)
 			}
 		})
 	}
 }
 
+var testWalletActionBatch = &swingtypes.MsgWalletActionBatch{
+	Actions: []swingtypes.WalletBatchAction{
+		{Action: `{"method":"tryExitOffer"}`},
+		{Action: `{"method":"executeOffer"}`, Spend: true},
+	},
+}
+
 func makeTestTx(msgs ...proto.Message) sdk.Tx {
 	wrappedMsgs := make([]*types.Any, len(msgs))
 	for i, m := range msgs {
@@ -186,6 +285,12 @@ type mockSwingsetKeeper struct {
 	mempoolLimit          int32
 	emptyQueueAllowed     bool
 	isHighPriorityOwner   bool
+	inboundPerTx          int32
+	senderQueueAllowed    []swingtypes.QueueSize
+	senderQueued          map[string]int32
+	acceptedBundles       map[string]bool
+	params                *swingtypes.Params
+	inboundPause          swingtypes.InboundPause
 }
 
 var _ SwingsetKeeper = mockSwingsetKeeper{}
@@ -199,12 +304,25 @@ func (msk mockSwingsetKeeper) GetState(ctx sdk.Context) swingtypes.State {
 	if msk.emptyQueueAllowed {
 		return swingtypes.State{}
 	}
-	return swingtypes.State{
+	state := swingtypes.State{
 		QueueAllowed: []swingtypes.QueueSize{
 			swingtypes.NewQueueSize(swingtypes.QueueInbound, msk.inboundLimit),
 			swingtypes.NewQueueSize(swingtypes.QueueInboundMempool, msk.mempoolLimit),
 		},
 	}
+	if msk.inboundPerTx != 0 {
+		state.QueueAllowed = append(state.QueueAllowed, swingtypes.NewQueueSize(swingtypes.QueueInboundPerTx, msk.inboundPerTx))
+	}
+	state.QueueAllowed = append(state.QueueAllowed, msk.senderQueueAllowed...)
+	return state
+}
+
+func (msk mockSwingsetKeeper) InboundQueueSenderCount(ctx sdk.Context, sender sdk.AccAddress, msgType string) (int32, error) {
+	return msk.senderQueued[msgType], nil
+}
+
+func (msk mockSwingsetKeeper) IsBundleAccepted(ctx sdk.Context, bundleID string) bool {
+	return msk.acceptedBundles[bundleID]
 }
 
 func (msk mockSwingsetKeeper) IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
@@ -212,7 +330,25 @@ func (msk mockSwingsetKeeper) IsHighPriorityAddress(ctx sdk.Context, addr sdk.Ac
 }
 
 func (msk mockSwingsetKeeper) GetBeansPerUnit(ctx sdk.Context) map[string]sdkmath.Uint {
-	return nil
+	if msk.params == nil {
+		return nil
+	}
+	beansPerUnit := make(map[string]sdkmath.Uint, len(msk.params.BeansPerUnit))
+	for _, bpu := range msk.params.BeansPerUnit {
+		beansPerUnit[bpu.Key] = bpu.Beans
+	}
+	return beansPerUnit
+}
+
+func (msk mockSwingsetKeeper) GetParams(ctx sdk.Context) swingtypes.Params {
+	if msk.params == nil {
+		return swingtypes.Params{}
+	}
+	return *msk.params
+}
+
+func (msk mockSwingsetKeeper) GetInboundPause(ctx sdk.Context) swingtypes.InboundPause {
+	return msk.inboundPause
 }
 
 func (msk mockSwingsetKeeper) ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.Uint) error {
@@ -0,0 +1,74 @@
+package ante
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// ErrInboundPaused is returned when a Tx carries an inbound message whose type
+// has been paused by governance.
+var ErrInboundPaused = swingtypes.ErrInboundPaused
+
+// pauseAnte is an sdk.AnteDecorator which enforces the governance pause of
+// inbound messages.
+type pauseAnte struct {
+	sk SwingsetKeeper
+}
+
+// NewInboundPauseDecorator returns an AnteDecorator which rejects Txs carrying
+// inbound messages paused by a MsgSetInboundPause. Only messages destined for
+// the SwingSet inbound queue are subject to the pause, so that governance and
+// other Cosmos-level messages keep working. If the pause allows it, messages
+// from high-priority senders are let through.
+func NewInboundPauseDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
+	return pauseAnte{sk: sk}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (pa pauseAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var pause *swingtypes.InboundPause
+	for _, msg := range tx.GetMsgs() {
+		camsg, ok := msg.(vm.ControllerAdmissionMsg)
+		if !ok {
+			continue
+		}
+		if pause == nil {
+			p := pa.sk.GetInboundPause(ctx)
+			pause = &p
+		}
+		if !pause.IsActive(ctx.BlockHeight()) {
+			break
+		}
+		msgType := sdk.MsgTypeURL(msg)
+		if !pause.Pauses(msgType) {
+			continue
+		}
+		if pause.AllowHighPriority {
+			isHighPriority, err := camsg.IsHighPriority(ctx, pa.sk)
+			if err != nil {
+				return ctx, err
+			}
+			if isHighPriority {
+				continue
+			}
+		}
+		defer telemetry.IncrCounterWithLabels(
+			[]string{"tx", "ante", "inbound_paused"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel("msg", msgType),
+			},
+		)
+		if pause.UntilHeight != 0 {
+			return ctx, sdkioerrors.Wrapf(ErrInboundPaused, "%s is paused until height %d", msgType, pause.UntilHeight)
+		}
+		return ctx, sdkioerrors.Wrapf(ErrInboundPaused, "%s is paused", msgType)
+	}
+	return next(ctx, tx, simulate)
+}
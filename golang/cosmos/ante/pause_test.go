@@ -0,0 +1,100 @@
+package ante
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestInboundPauseAnteHandle(t *testing.T) {
+	walletActionURL := sdk.MsgTypeURL(&swingtypes.MsgWalletAction{})
+	walletSpendActionURL := sdk.MsgTypeURL(&swingtypes.MsgWalletSpendAction{})
+	for _, tt := range []struct {
+		name                string
+		tx                  sdk.Tx
+		pause               swingtypes.InboundPause
+		isHighPriorityOwner bool
+		wantErr             bool
+	}{
+		{
+			name: "no-pause",
+			tx:   makeTestTx(&swingtypes.MsgWalletAction{}),
+		},
+		{
+			name:    "paused-type",
+			tx:      makeTestTx(&swingtypes.MsgWalletAction{}),
+			pause:   swingtypes.InboundPause{MsgTypeURLs: []string{walletActionURL}},
+			wantErr: true,
+		},
+		{
+			name:  "other-type",
+			tx:    makeTestTx(&swingtypes.MsgDeliverInbound{}),
+			pause: swingtypes.InboundPause{MsgTypeURLs: []string{walletActionURL}},
+		},
+		{
+			name:    "paused-all",
+			tx:      makeTestTx(&swingtypes.MsgDeliverInbound{}),
+			pause:   swingtypes.InboundPause{All: true},
+			wantErr: true,
+		},
+		{
+			name:  "paused-all-non-inbound",
+			tx:    makeTestTx(&banktypes.MsgSend{}),
+			pause: swingtypes.InboundPause{All: true},
+		},
+		{
+			name:    "paused-later-msg",
+			tx:      makeTestTx(&banktypes.MsgSend{}, &swingtypes.MsgDeliverInbound{}, &swingtypes.MsgWalletAction{}),
+			pause:   swingtypes.InboundPause{MsgTypeURLs: []string{walletActionURL}},
+			wantErr: true,
+		},
+		{
+			name:    "paused-until-later",
+			tx:      makeTestTx(&swingtypes.MsgWalletAction{}),
+			pause:   swingtypes.InboundPause{All: true, UntilHeight: 11},
+			wantErr: true,
+		},
+		{
+			name:  "pause-expired",
+			tx:    makeTestTx(&swingtypes.MsgWalletAction{}),
+			pause: swingtypes.InboundPause{All: true, UntilHeight: 10},
+		},
+		{
+			name:                "high-priority-not-allowed",
+			tx:                  makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			pause:               swingtypes.InboundPause{MsgTypeURLs: []string{walletSpendActionURL}},
+			isHighPriorityOwner: true,
+			wantErr:             true,
+		},
+		{
+			name:                "high-priority-allowed",
+			tx:                  makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			pause:               swingtypes.InboundPause{MsgTypeURLs: []string{walletSpendActionURL}, AllowHighPriority: true},
+			isHighPriorityOwner: true,
+		},
+		{
+			name:    "low-priority-allowed",
+			tx:      makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			pause:   swingtypes.InboundPause{MsgTypeURLs: []string{walletSpendActionURL}, AllowHighPriority: true},
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithContext(context.Background()).WithBlockHeight(10)
+			mock := mockSwingsetKeeper{inboundPause: tt.pause, isHighPriorityOwner: tt.isHighPriorityOwner}
+			decorator := NewInboundPauseDecorator(mock)
+			_, err := decorator.AnteHandle(ctx, tt.tx, false, nilAnteHandler)
+			if err != nil && !tt.wantErr {
+				t.Errorf("want no error, got %s", err.Error())
+			} else if err == nil && tt.wantErr {
+				t.Errorf("want error, got none")
+			} else if err != nil && !errors.Is(err, ErrInboundPaused) {
+				t.Errorf("want ErrInboundPaused, got %s", err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package ante
+
+import (
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+/*
+This AnteDecorator sets the CometBFT mempool priority of Txs carrying
+Cosmos-messages which end up on Swingset's message queues. The priority is the
+fee paid per bean the messages are estimated to cost, so that when the mempool
+is contended (e.g. the QueueInboundMempool allowance is tight), the prioritized
+mempool reaps the highest-paying Txs first, and they take the remaining inbound
+queue slots at DeliverTx time. Txs with messages from high priority senders
+are boosted above all others.
+
+Priorities are only honored by the prioritized mempool (`version = "v1"` in
+the `[mempool]` section of config.toml).
+*/
+
+const (
+	// PriorityPerFeeBean scales the ratio of paid beans to estimated beans,
+	// so that paying for exactly the estimated beans gives this priority.
+	PriorityPerFeeBean = int64(1_000_000)
+
+	// HighPriorityBoost is added to the priority of Txs with messages from
+	// high priority senders.
+	HighPriorityBoost = int64(1) << 48
+)
+
+// priorityAnte is an sdk.AnteDecorator which prioritizes Swingset messages by fee.
+type priorityAnte struct {
+	sk SwingsetKeeper
+}
+
+// NewPriorityDecorator returns an AnteDecorator which sets the priority of Txs
+// carrying vm.ControllerAdmissionMsgs according to their fee per estimated
+// bean. It must come after the DeductFeeDecorator, whose priority it replaces.
+func NewPriorityDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
+	return priorityAnte{sk: sk}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (pa priorityAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	var beansPerUnit map[string]sdkmath.Uint
+	estimatedBeans := sdkmath.ZeroUint()
+	isHighPriority := false
+	for _, msg := range tx.GetMsgs() {
+		camsg, ok := msg.(vm.ControllerAdmissionMsg)
+		if !ok {
+			continue
+		}
+		if beansPerUnit == nil {
+			beansPerUnit = pa.sk.GetBeansPerUnit(ctx)
+		}
+		estimatedBeans = estimatedBeans.Add(swingtypes.EstimateAdmissionBeans(beansPerUnit, camsg))
+		highPriority, err := camsg.IsHighPriority(ctx, pa.sk)
+		if err != nil {
+			return ctx, err
+		}
+		isHighPriority = isHighPriority || highPriority
+	}
+	if beansPerUnit == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	paidBeans := feeBeans(feeTx.GetFee(), pa.sk.GetParams(ctx).FeeUnitPrice, beansPerUnit[swingtypes.BeansPerFeeUnit])
+	priority := beansPriority(paidBeans, estimatedBeans)
+	if isHighPriority {
+		if priority > math.MaxInt64-HighPriorityBoost {
+			priority = math.MaxInt64
+		} else {
+			priority += HighPriorityBoost
+		}
+	}
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// feeBeans converts the fee to beans at the fee unit price, summing over the
+// denoms of the price.
+func feeBeans(fee sdk.Coins, feeUnitPrice sdk.Coins, beansPerFeeUnit sdkmath.Uint) sdkmath.Int {
+	beans := sdk.ZeroInt()
+	if beansPerFeeUnit.IsNil() {
+		return beans
+	}
+	for _, price := range feeUnitPrice {
+		if !price.Amount.IsPositive() {
+			continue
+		}
+		amount := fee.AmountOf(price.Denom)
+		if !amount.IsPositive() {
+			continue
+		}
+		beans = beans.Add(amount.Mul(sdkmath.NewIntFromBigInt(beansPerFeeUnit.BigInt())).Quo(price.Amount))
+	}
+	return beans
+}
+
+// beansPriority returns the priority for paying paidBeans for estimatedBeans.
+func beansPriority(paidBeans sdkmath.Int, estimatedBeans sdkmath.Uint) int64 {
+	if estimatedBeans.IsZero() {
+		estimatedBeans = sdkmath.OneUint()
+	}
+	priority := paidBeans.MulRaw(PriorityPerFeeBean).Quo(sdkmath.NewIntFromBigInt(estimatedBeans.BigInt()))
+	if !priority.IsInt64() {
+		return math.MaxInt64
+	}
+	return priority.Int64()
+}
@@ -0,0 +1,67 @@
+package ante
+
+import (
+	"context"
+	"testing"
+
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+func makeTestFeeTx(fee sdk.Coins, msgs ...proto.Message) sdk.Tx {
+	feeTx := makeTestTx(msgs...).(*tx.Tx)
+	feeTx.AuthInfo = &tx.AuthInfo{
+		Fee: &tx.Fee{Amount: fee},
+	}
+	return feeTx
+}
+
+func TestPriorityAnteHandle(t *testing.T) {
+	params := swingtypes.DefaultParams()
+	fee := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("uist", amt))
+	}
+	priorityOf := func(t *testing.T, tx sdk.Tx, isHighPriorityOwner bool) int64 {
+		ctx := sdk.Context{}.WithContext(context.Background()).WithPriority(7)
+		mock := mockSwingsetKeeper{params: &params, isHighPriorityOwner: isHighPriorityOwner}
+		decorator := NewPriorityDecorator(mock)
+		var priority int64
+		_, err := decorator.AnteHandle(ctx, tx, false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			priority = ctx.Priority()
+			return ctx, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return priority
+	}
+
+	if got := priorityOf(t, makeTestFeeTx(fee(1_000_000), &banktypes.MsgSend{}), false); got != 7 {
+		t.Errorf("non-swingset tx priority changed to %d", got)
+	}
+
+	free := priorityOf(t, makeTestFeeTx(nil, &swingtypes.MsgWalletAction{}), false)
+	if free != 0 {
+		t.Errorf("want zero priority without fee, got %d", free)
+	}
+	low := priorityOf(t, makeTestFeeTx(fee(10_000), &swingtypes.MsgWalletAction{}), false)
+	high := priorityOf(t, makeTestFeeTx(fee(20_000), &swingtypes.MsgWalletAction{}), false)
+	if !(0 < low && low < high) {
+		t.Errorf("want increasing priorities with fee, got %d and %d", low, high)
+	}
+	otherDenom := priorityOf(t, makeTestFeeTx(sdk.NewCoins(sdk.NewInt64Coin("ubld", 20_000)), &swingtypes.MsgWalletAction{}), false)
+	if otherDenom != 0 {
+		t.Errorf("want zero priority for fee not in the fee unit price, got %d", otherDenom)
+	}
+	bigger := priorityOf(t, makeTestFeeTx(fee(20_000), &swingtypes.MsgWalletAction{Action: "a much longer action than the empty one"}), false)
+	if !(bigger < high) {
+		t.Errorf("want lower priority for more estimated beans, got %d for %d", bigger, high)
+	}
+	boosted := priorityOf(t, makeTestFeeTx(fee(10_000), &swingtypes.MsgWalletSpendAction{}), true)
+	if boosted < HighPriorityBoost || boosted <= high {
+		t.Errorf("want boosted priority for high priority sender, got %d", boosted)
+	}
+}
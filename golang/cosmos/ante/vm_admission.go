@@ -27,15 +27,21 @@ func NewAdmissionDecorator(data interface{}) AdmissionDecorator {
 
 // AnteHandle calls CheckAdmissibility for all messages that implement the
 // vm.ControllerAdmissionMsg interface.  If it returns an error, refuse the
-// entire transaction.
+// entire transaction. If the Tx fees are paid by a feegrant allowance, the
+// granter is made available to CheckAdmissibility through vm.FeeGrant.
 func (ad AdmissionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	msgs := tx.GetMsgs()
 	errors := make([]error, 0, len(msgs))
 
+	admissionCtx := ctx
+	if feeTx, ok := tx.(sdk.FeeTx); ok && feeTx.FeeGranter() != nil {
+		admissionCtx = vm.WithFeeGrant(ctx, feeTx.FeeGranter(), msgs)
+	}
+
 	// Ask the controller if we are rejecting messages.
 	for _, msg := range tx.GetMsgs() {
 		if camsg, ok := msg.(vm.ControllerAdmissionMsg); ok {
-			if err := camsg.CheckAdmissibility(ctx, ad.data); err != nil {
+			if err := camsg.CheckAdmissibility(admissionCtx, ad.data); err != nil {
 				// Only let admission errors interrupt the transaction if we're not
 				// simulating, otherwise our gas estimation will be too low.
 				if !simulate {
@@ -145,6 +145,16 @@ const appName = "agoric"
 // TODO: document this flag in config, likely alongside the genesis path
 const FlagSwingStoreExportDir = "swing-store-export-dir"
 
+// FlagSwingStoreBaseExportDir defines the config flag used to specify the
+// swing-store directory of a previous genesis export. If set, a genesis export
+// is layered on top of it, only including new or changed artifacts.
+const FlagSwingStoreBaseExportDir = "swing-store-base-export-dir"
+
+// FlagSwingStoreExportDataFormat defines the config flag used to specify the
+// format of the "export data" file of a genesis swing-store export, like
+// "binary+zstd". The default is JSON lines.
+const FlagSwingStoreExportDataFormat = "swing-store-export-data-format"
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -229,6 +239,10 @@ type GaiaApp struct { // nolint: golint
 
 	upgradeDetails *upgradeDetails
 
+	// sendOutOfBlock sends an action handled by the controller outside of the
+	// block lifecycle, which is safe to call from a goroutine.
+	sendOutOfBlock func(action vm.Jsonable) (string, error)
+
 	invCheckPeriod uint
 
 	// keys to access the substores
@@ -476,24 +490,28 @@ func NewAgoricApp(
 	// The SwingSetKeeper is the Keeper from the SwingSet module
 	app.SwingSetKeeper = swingset.NewKeeper(
 		appCodec, keys[swingset.StoreKey], app.GetSubspace(swingset.ModuleName),
-		app.AccountKeeper, app.BankKeeper,
+		app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper,
 		app.VstorageKeeper, vbanktypes.ReservePoolName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		callToController,
 	)
 	app.swingsetPort = app.AgdServer.MustRegisterPortHandler("swingset", swingset.NewPortHandler(app.SwingSetKeeper))
 
+	app.sendOutOfBlock = func(action vm.Jsonable) (string, error) {
+		bz, err := json.Marshal(action)
+		if err != nil {
+			return "", err
+		}
+		return sendToController(context.Background(), true, string(bz))
+	}
+
 	app.SwingStoreExportsHandler = *swingsetkeeper.NewSwingStoreExportsHandler(
 		app.Logger(),
 		func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
 			if mustNotBeInited {
 				app.CheckControllerInited(false)
 			}
-
-			bz, err := json.Marshal(action)
-			if err != nil {
-				return "", err
-			}
-			return sendToController(context.Background(), true, string(bz))
+			return app.sendOutOfBlock(action)
 		},
 	)
 
@@ -506,11 +524,20 @@ func NewAgoricApp(
 		}
 		return agorictypes.NewKVIteratorReader(exportDataIterator)
 	}
+	swingsetConfig, err := swingset.SwingsetConfigFromViper(appOpts)
+	if err != nil {
+		panic(err)
+	}
 	app.SwingSetSnapshotter = *swingsetkeeper.NewExtensionSnapshotter(
 		bApp,
 		&app.SwingStoreExportsHandler,
 		getSwingStoreExportDataShadowCopyReader,
+		swingsetConfig.SnapshotCompressionLevel,
+		filepath.Join(homePath, "data", "swing-store-restore"),
+		swingsetConfig.SnapshotArtifactMode,
+		swingsetConfig.SnapshotRestoreArtifactMode,
 	)
+	app.SwingSetKeeper.SetExportDataCheckInterval(swingsetConfig.ExportDataCheckInterval)
 
 	app.VibcKeeper = vibc.NewKeeper(
 		appCodec,
@@ -564,6 +591,9 @@ func NewAgoricApp(
 		app.BaseApp.MsgServiceRouter(),
 		govConfig,
 	)
+	app.GovKeeper.SetHooks(
+		govtypes.NewMultiGovHooks(app.SwingSetKeeper.Hooks()),
+	)
 
 	// Initialize the packet forward middleware Keeper
 	// It's important to note that the PFM Keeper must be initialized before the Transfer Keeper
@@ -661,6 +691,10 @@ func NewAgoricApp(
 	app.EvidenceKeeper = *evidenceKeeper
 
 	swingStoreExportDir := cast.ToString(appOpts.Get(FlagSwingStoreExportDir))
+	swingStoreExportOptions := swingset.GenesisSwingStoreExportOptions{
+		BaseExportDir:    cast.ToString(appOpts.Get(FlagSwingStoreBaseExportDir)),
+		ExportDataFormat: cast.ToString(appOpts.Get(FlagSwingStoreExportDataFormat)),
+	}
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -690,7 +724,7 @@ func NewAgoricApp(
 		icaModule,
 		packetforward.NewAppModule(app.PacketForwardKeeper),
 		vstorage.NewAppModule(app.VstorageKeeper),
-		swingset.NewAppModule(app.SwingSetKeeper, &app.SwingStoreExportsHandler, setBootstrapNeeded, app.ensureControllerInited, swingStoreExportDir),
+		swingset.NewAppModule(app.SwingSetKeeper, &app.SwingStoreExportsHandler, setBootstrapNeeded, app.ensureControllerInited, swingStoreExportDir, swingStoreExportOptions),
 		vibcModule,
 		vbankModule,
 		vtransferModule,
@@ -1063,7 +1097,7 @@ func (app *GaiaApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 
 // Commit tells the controller that the block is commited
 func (app *GaiaApp) Commit() abci.ResponseCommit {
-	err := swingsetkeeper.WaitUntilSwingStoreExportStarted()
+	err := app.SwingStoreExportsHandler.WaitUntilSwingStoreExportStarted()
 
 	if err != nil {
 		app.Logger().Error("swing-store export failed to start", "err", err)
@@ -1082,6 +1116,8 @@ func (app *GaiaApp) Commit() abci.ResponseCommit {
 		panic(err.Error())
 	}
 
+	app.initiateSwingStoreExportDataCheck(app.LastBlockHeight())
+
 	if snapshotHeight > 0 {
 		err = app.SwingSetSnapshotter.InitiateSnapshot(snapshotHeight)
 
@@ -1093,6 +1129,22 @@ func (app *GaiaApp) Commit() abci.ResponseCommit {
 	return res
 }
 
+// initiateSwingStoreExportDataCheck starts checking the swing-store export data
+// of the block just committed at the given height, if due, over a read-only
+// view of that block.
+func (app *GaiaApp) initiateSwingStoreExportDataCheck(height int64) {
+	if app.SwingSetKeeper.GetExportDataCheckInterval() <= 0 {
+		return
+	}
+	cms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		app.Logger().Error("cannot check swing-store export data", "height", height, "err", err)
+		return
+	}
+	ctx := sdk.NewContext(cms, tmproto.Header{Height: height}, false, app.Logger())
+	swingset.InitiateSwingStoreExportDataCheck(ctx, app.SwingSetKeeper, app.sendOutOfBlock)
+}
+
 // LoadHeight loads a particular height
 func (app *GaiaApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
@@ -0,0 +1,283 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// The swing-store of the JS VM only holds the latest state, so an export at
+// an earlier height cannot be performed in place. Instead, if a state-sync
+// snapshot of that height is available locally, it is restored into a scratch
+// home, where the export is performed at the latest height, and the scratch
+// home is then removed, even if the export is interrupted. The scratch home
+// only gets the app.toml and config.toml of the home, not its keys.
+
+// scratchHomePattern is the os.MkdirTemp pattern of the scratch home created
+// in the application home for an export at a historical height.
+const scratchHomePattern = "export-scratch-"
+
+// latestCommittedHeight returns the latest height committed to the
+// application database of a home directory.
+func latestCommittedHeight(home string, backend dbm.BackendType) (int64, error) {
+	db, err := dbm.NewDB("application", backend, filepath.Join(home, "data"))
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+	return rootmulti.GetLatestVersion(db), nil
+}
+
+// openSnapshotStore opens the state-sync snapshot store of a home directory.
+// The returned database must be closed once done with the store.
+func openSnapshotStore(home string, backend dbm.BackendType) (*snapshots.Store, dbm.DB, error) {
+	snapshotDir := filepath.Join(home, "data", "snapshots")
+	if err := os.MkdirAll(snapshotDir, os.ModePerm); err != nil {
+		return nil, nil, err
+	}
+	db, err := dbm.NewDB("metadata", backend, snapshotDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	store, err := snapshots.NewStore(db, snapshotDir)
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	return store, db, nil
+}
+
+// findLocalSnapshot returns the local snapshot at the given height with the
+// highest format, or nil if there is none.
+func findLocalSnapshot(store *snapshots.Store, height uint64) (*snapshottypes.Snapshot, error) {
+	snapshotList, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+	// The list is ordered by descending height, then descending format.
+	for _, snapshot := range snapshotList {
+		if snapshot.Height == height {
+			return snapshot, nil
+		}
+	}
+	return nil, nil
+}
+
+// scratchConfigFiles are the configuration files of a home copied into a
+// scratch home.
+var scratchConfigFiles = []string{"app.toml", "config.toml"}
+
+// genesisFileConfigPattern matches the genesis_file entry of a config.toml.
+var genesisFileConfigPattern = regexp.MustCompile(`(?m)^genesis_file\s*=.*$`)
+
+// copyScratchConfigFiles copies the scratchConfigFiles of a home directory
+// into a scratch home, pointing its config.toml at the given genesis file.
+func copyScratchConfigFiles(fromHome, toHome, genesisFile string) error {
+	toDir := filepath.Join(toHome, "config")
+	if err := os.MkdirAll(toDir, os.ModePerm); err != nil {
+		return err
+	}
+	absGenesisFile, err := filepath.Abs(genesisFile)
+	if err != nil {
+		return err
+	}
+	for _, name := range scratchConfigFiles {
+		fromPath := filepath.Join(fromHome, "config", name)
+		info, err := os.Stat(fromPath)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(fromPath)
+		if err != nil {
+			return err
+		}
+		if name == "config.toml" {
+			genesisFileConfig := "genesis_file = " + strconv.Quote(absGenesisFile)
+			if genesisFileConfigPattern.Match(data) {
+				data = genesisFileConfigPattern.ReplaceAllLiteral(data, []byte(genesisFileConfig))
+			} else {
+				data = append([]byte(genesisFileConfig+"\n"), data...)
+			}
+		}
+		if err := os.WriteFile(filepath.Join(toDir, name), data, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HistoricalExportHome is a scratch home created in the home of a node to
+// export a past height from a local state-sync snapshot of that height.
+type HistoricalExportHome struct {
+	// Dir is the directory of the scratch home.
+	Dir string
+	// Snapshot is the local snapshot copied into the scratch home.
+	Snapshot *snapshottypes.Snapshot
+}
+
+// NewHistoricalExportHome creates a scratch home in the given home, with the
+// configuration of the home using the given genesis file, and a copy of the
+// local snapshot at the given height ready to be restored. It fails if there is
+// no such snapshot. The caller is responsible for removing the scratch home.
+func NewHistoricalExportHome(home, genesisFile string, backend dbm.BackendType, height int64) (*HistoricalExportHome, error) {
+	store, db, err := openSnapshotStore(home, backend)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	snapshot, err := findLocalSnapshot(store, uint64(height))
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("no local snapshot at height %d to restore", height)
+	}
+
+	scratchHome, err := os.MkdirTemp(home, scratchHomePattern)
+	if err != nil {
+		return nil, err
+	}
+	err = copyScratchConfigFiles(home, scratchHome, genesisFile)
+	if err == nil {
+		err = copySnapshot(store, scratchHome, backend, snapshot)
+	}
+	if err != nil {
+		os.RemoveAll(scratchHome)
+		return nil, err
+	}
+	return &HistoricalExportHome{Dir: scratchHome, Snapshot: snapshot}, nil
+}
+
+// Remove removes the scratch home.
+func (scratch *HistoricalExportHome) Remove() error {
+	return os.RemoveAll(scratch.Dir)
+}
+
+// copySnapshot saves a snapshot of the store into the snapshot store of
+// another home directory.
+func copySnapshot(store *snapshots.Store, toHome string, backend dbm.BackendType, snapshot *snapshottypes.Snapshot) error {
+	toStore, toDB, err := openSnapshotStore(toHome, backend)
+	if err != nil {
+		return err
+	}
+	defer toDB.Close()
+
+	_, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	if err != nil {
+		return err
+	}
+	if chunks == nil {
+		return fmt.Errorf("snapshot at height %d, format %d is no longer available", snapshot.Height, snapshot.Format)
+	}
+	copied, err := toStore.Save(snapshot.Height, snapshot.Format, chunks)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(copied.Hash, snapshot.Hash) {
+		return fmt.Errorf("snapshot at height %d, format %d has hash %X, expected %X",
+			snapshot.Height, snapshot.Format, copied.Hash, snapshot.Hash)
+	}
+	return nil
+}
+
+// HistoricalExportArgs returns the command-line arguments of the export
+// command to run in a scratch home for an export at a past height, which are
+// those of the flags set on the given export command, except the home and
+// height.
+func HistoricalExportArgs(cmd *cobra.Command) []string {
+	args := []string{"export"}
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if flag.Name == flags.FlagHome || flag.Name == server.FlagHeight {
+			return
+		}
+		if sliceValue, ok := flag.Value.(pflag.SliceValue); ok {
+			for _, value := range sliceValue.GetSlice() {
+				args = append(args, "--"+flag.Name+"="+value)
+			}
+			return
+		}
+		args = append(args, "--"+flag.Name+"="+flag.Value.String())
+	})
+	return args
+}
+
+// runInScratchHome runs a command of the current executable in the scratch
+// home, sending its output to the error output of cmd. The command is killed
+// if the context is done.
+func runInScratchHome(ctx context.Context, cmd *cobra.Command, scratchHome string, args ...string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	args = append(args, "--"+flags.FlagHome, scratchHome)
+	subCmd := exec.CommandContext(ctx, executable, args...)
+	subCmd.Stdout = cmd.ErrOrStderr()
+	subCmd.Stderr = cmd.ErrOrStderr()
+	if err := subCmd.Run(); err != nil {
+		return fmt.Errorf("%s %s failed: %w", filepath.Base(executable), args[0], err)
+	}
+	return nil
+}
+
+// exportAtHistoricalHeight performs the export of the command at a height
+// other than the latest of the home, from a local state-sync snapshot of that
+// height restored into a scratch home.
+func exportAtHistoricalHeight(cmd *cobra.Command, home, genesisFile string, backend dbm.BackendType, height int64, latestHeight int64) error {
+	scratch, err := NewHistoricalExportHome(home, genesisFile, backend, height)
+	if err != nil {
+		return fmt.Errorf("cannot export at height %d: the latest height is %d, and %w", height, latestHeight, err)
+	}
+	defer scratch.Remove()
+
+	// Interrupting the export kills the command running in the scratch home, so
+	// that the scratch home is removed before exiting.
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	snapshot := scratch.Snapshot
+	fmt.Fprintf(cmd.ErrOrStderr(), "Restoring snapshot at height %d, format %d into %s\n", snapshot.Height, snapshot.Format, scratch.Dir)
+	restoreArgs := []string{"snapshots", "restore", strconv.FormatUint(snapshot.Height, 10), strconv.FormatUint(uint64(snapshot.Format), 10)}
+	if splitVm, _ := cmd.Flags().GetString(FlagSplitVm); splitVm != "" {
+		restoreArgs = append(restoreArgs, "--"+FlagSplitVm, splitVm)
+	}
+	if err := runInScratchHome(ctx, cmd, scratch.Dir, restoreArgs...); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "Exporting at height %d\n", height)
+	return runInScratchHome(ctx, cmd, scratch.Dir, HistoricalExportArgs(cmd)...)
+}
+
+// exportIsHistorical returns the latest height of the home, and whether an
+// export at the requested height cannot be performed in place.
+func exportIsHistorical(home string, backend dbm.BackendType, height int64) (int64, bool, error) {
+	if height == -1 {
+		return 0, false, nil
+	}
+	latestHeight, err := latestCommittedHeight(home, backend)
+	if err != nil {
+		return 0, false, err
+	}
+	return latestHeight, height != latestHeight, nil
+}
@@ -0,0 +1,117 @@
+package cmd_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/daemon/cmd"
+)
+
+func TestHistoricalExportHome(t *testing.T) {
+	home := t.TempDir()
+	backend := dbm.GoLevelDBBackend
+	configDir := filepath.Join(home, "config")
+	require.NoError(t, os.MkdirAll(filepath.Join(configDir, cmd.ExportedSwingStoreDirectoryName), 0755))
+	for name, content := range map[string]string{
+		"app.toml":                "pruning = \"nothing\"\n",
+		"config.toml":             "moniker = \"node\"\ngenesis_file = \"config/genesis.json\"\n",
+		"genesis.json":            "{}",
+		"node_key.json":           "{\"priv_key\":{}}",
+		"priv_validator_key.json": "{\"priv_key\":{}}",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(configDir, name), []byte(content), 0600))
+	}
+	genesisFile := filepath.Join(configDir, "genesis.json")
+
+	snapshotDir := filepath.Join(home, "data", "snapshots")
+	require.NoError(t, os.MkdirAll(snapshotDir, 0755))
+	db, err := dbm.NewDB("metadata", backend, snapshotDir)
+	require.NoError(t, err)
+	store, err := snapshots.NewStore(db, snapshotDir)
+	require.NoError(t, err)
+	chunks := make(chan io.ReadCloser, 2)
+	chunks <- io.NopCloser(bytes.NewBufferString("chunk 0"))
+	chunks <- io.NopCloser(bytes.NewBufferString("chunk 1"))
+	close(chunks)
+	saved, err := store.Save(5, 3, chunks)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	_, err = cmd.NewHistoricalExportHome(home, genesisFile, backend, 4)
+	require.ErrorContains(t, err, "no local snapshot at height 4")
+
+	scratch, err := cmd.NewHistoricalExportHome(home, genesisFile, backend, 5)
+	require.NoError(t, err)
+	require.Equal(t, home, filepath.Dir(scratch.Dir))
+	require.Equal(t, saved, scratch.Snapshot)
+
+	// Only the app.toml and config.toml are copied, using the genesis file of
+	// the home.
+	entries, err := os.ReadDir(filepath.Join(scratch.Dir, "config"))
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	require.Equal(t, []string{"app.toml", "config.toml"}, names)
+	config, err := os.ReadFile(filepath.Join(scratch.Dir, "config", "config.toml"))
+	require.NoError(t, err)
+	require.Equal(t, "moniker = \"node\"\ngenesis_file = "+strconv.Quote(genesisFile)+"\n", string(config))
+
+	scratchSnapshotDir := filepath.Join(scratch.Dir, "data", "snapshots")
+	scratchDB, err := dbm.NewDB("metadata", backend, scratchSnapshotDir)
+	require.NoError(t, err)
+	scratchStore, err := snapshots.NewStore(scratchDB, scratchSnapshotDir)
+	require.NoError(t, err)
+	copied, err := scratchStore.Get(5, 3)
+	require.NoError(t, err)
+	require.Equal(t, saved, copied)
+	require.NoError(t, scratchDB.Close())
+
+	require.NoError(t, scratch.Remove())
+	require.NoDirExists(t, scratch.Dir)
+}
+
+func TestHistoricalExportArgs(t *testing.T) {
+	exportCmd := &cobra.Command{Run: func(*cobra.Command, []string) {}}
+	exportCmd.Flags().String("home", "", "")
+	exportCmd.Flags().Int64(server.FlagHeight, -1, "")
+	exportCmd.Flags().Bool(server.FlagForZeroHeight, false, "")
+	exportCmd.Flags().StringSlice(server.FlagJailAllowedAddrs, []string{}, "")
+	exportCmd.Flags().String(cmd.FlagExportDir, "", "")
+	exportCmd.SetArgs([]string{
+		"--home=node", "--height=5", "--for-zero-height", "--jail-allowed-addrs=a,b", "--export-dir=out",
+	})
+	require.NoError(t, exportCmd.Execute())
+
+	require.Equal(t, []string{
+		"export",
+		"--export-dir=out",
+		"--for-zero-height=true",
+		"--jail-allowed-addrs=a",
+		"--jail-allowed-addrs=b",
+	}, cmd.HistoricalExportArgs(exportCmd))
+}
+
+func TestExportAtHistoricalHeightWithoutSnapshot(t *testing.T) {
+	home := t.TempDir()
+	rootCmd, _ := cmd.NewRootCmd(nil)
+	rootCmd.SetArgs([]string{
+		"export", "--home", home, "--height", "5", "--export-dir", filepath.Join(home, "export"),
+	})
+	rootCmd.SetOut(io.Discard)
+	rootCmd.SetErr(io.Discard)
+	err := svrcmd.Execute(rootCmd, "", home)
+	require.ErrorContains(t, err, "cannot export at height 5: the latest height is 0, and no local snapshot at height 5 to restore")
+}
@@ -36,6 +36,7 @@ import (
 	gaia "github.com/Agoric/agoric-sdk/golang/cosmos/app"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset"
 	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
 )
 
@@ -80,7 +81,7 @@ func NewRootCmd(sender vm.Sender) (*cobra.Command, params.EncodingConfig) {
 			}
 
 			customAppTemplate, customAppConfig := initAppConfig()
-			customTMConfig := initTendermintConfig()
+			customTMConfig := initTendermintConfig(initClientCtx.HomeDir)
 			return server.InterceptConfigsPreRunHandler(cmd, customAppTemplate, customAppConfig, customTMConfig)
 		},
 	}
@@ -90,12 +91,32 @@ func NewRootCmd(sender vm.Sender) (*cobra.Command, params.EncodingConfig) {
 	return rootCmd, encodingConfig
 }
 
-func initTendermintConfig() *tmcfg.Config {
+// initTendermintConfig returns the Tendermint config to which the config.toml
+// of the given home applies, which is also the template written if the home
+// has no config.toml yet.
+func initTendermintConfig(homeDir string) *tmcfg.Config {
 	cfg := tmcfg.DefaultConfig()
 	// customize config here
+	if _, err := os.Stat(filepath.Join(homeDir, "config", "config.toml")); err == nil {
+		// Leave an existing config.toml as its operator wrote it, even if it
+		// predates the mempool version setting.
+		return cfg
+	}
+	// Use the prioritized mempool in new homes, so that Swingset messages
+	// paying a higher fee per bean are included first when the inbound queue
+	// is contended.
+	cfg.Mempool.Version = tmcfg.MempoolV1
 	return cfg
 }
 
+// CustomAppConfig extends the server config with the config of the Agoric
+// modules.
+type CustomAppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	Swingset swingset.SwingsetConfig `mapstructure:"swingset"`
+}
+
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
@@ -116,7 +137,12 @@ func initAppConfig() (string, interface{}) {
 	// For now, we set it to zero so that validators don't have to worry about it.
 	srvCfg.MinGasPrices = "0uist"
 
-	return serverconfig.DefaultConfigTemplate, *srvCfg
+	customAppConfig := CustomAppConfig{
+		Config:   *srvCfg,
+		Swingset: swingset.DefaultSwingsetConfig,
+	}
+
+	return serverconfig.DefaultConfigTemplate + swingset.DefaultConfigTemplate, customAppConfig
 }
 
 func initRootCmd(sender vm.Sender, rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
@@ -142,6 +168,7 @@ func initRootCmd(sender vm.Sender, rootCmd *cobra.Command, encodingConfig params
 		config.Cmd(),
 		pruning.Cmd(ac.newSnapshotsApp, gaia.DefaultNodeHome),
 		snapshot.Cmd(ac.newSnapshotsApp),
+		SwingStoreCmd(encodingConfig.Marshaler),
 	)
 
 	server.AddCommands(rootCmd, gaia.DefaultNodeHome, ac.newApp, ac.appExport, addModuleInitFlags)
@@ -338,6 +365,15 @@ const (
 	// ExportedSwingStoreDirectoryName is the directory name used to save the swing-store
 	// export (artifacts only) in the export-dir
 	ExportedSwingStoreDirectoryName = "swing-store"
+	// FlagBaseExportDir is the command-line flag for the "export" command
+	// specifying the export-dir of a previous export. If set, the swing-store
+	// export only contains the artifacts that are new or changed since then,
+	// and references the others from the previous export.
+	FlagBaseExportDir = "base-export-dir"
+	// FlagExportDataFormat is the command-line flag for the "export" command
+	// specifying the format of the swing-store "export data" file, like
+	// "binary+zstd". The default is JSON lines.
+	FlagExportDataFormat = "export-data-format"
 )
 
 // extendCosmosExportCommand monkey-patches the "export" command added by
@@ -345,6 +381,8 @@ const (
 // genesis export in the specified directory if the VM is running.
 func extendCosmosExportCommand(cmd *cobra.Command) {
 	cmd.Flags().String(FlagExportDir, "", "The directory where to create the genesis export")
+	cmd.Flags().String(FlagBaseExportDir, "", "The directory of a previous genesis export on which to layer the swing-store export")
+	cmd.Flags().String(FlagExportDataFormat, "", `The format of the swing-store "export data": jsonl (default) or binary, optionally followed by +gzip or +zstd`)
 	err := cmd.MarkFlagRequired(FlagExportDir)
 	if err != nil {
 		panic(err)
@@ -373,6 +411,30 @@ func extendCosmosExportCommand(cmd *cobra.Command) {
 		// want to override any swing-store artifacts that may be associated to the
 		// current genesis.
 		serverCtx.Viper.Set(gaia.FlagSwingStoreExportDir, swingStoreExportPath)
+		if baseExportDir, _ := cmd.Flags().GetString(FlagBaseExportDir); baseExportDir != "" {
+			serverCtx.Viper.Set(gaia.FlagSwingStoreBaseExportDir, filepath.Join(baseExportDir, ExportedSwingStoreDirectoryName))
+		}
+		if exportDataFormat, _ := cmd.Flags().GetString(FlagExportDataFormat); exportDataFormat != "" {
+			if err := swingsetkeeper.ValidateExportDataFormat(exportDataFormat); err != nil {
+				return err
+			}
+			serverCtx.Viper.Set(gaia.FlagSwingStoreExportDataFormat, exportDataFormat)
+		}
+
+		if !hasVMController(serverCtx) {
+			// The swing-store cannot be exported at a past height in place, so such
+			// an export is performed from a restore of a local snapshot instead.
+			home := serverCtx.Config.RootDir
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			backend := server.GetAppDBBackend(serverCtx.Viper)
+			latestHeight, historical, err := exportIsHistorical(home, backend, height)
+			if err != nil {
+				return err
+			}
+			if historical {
+				return exportAtHistoricalHeight(cmd, home, serverCtx.Config.GenesisFile(), backend, height, latestHeight)
+			}
+		}
 
 		if hasVMController(serverCtx) {
 			// Capture the export in the genesisPath.
@@ -481,7 +543,7 @@ func replaceCosmosSnapshotExportCommand(cmd *cobra.Command, ac appCreator) {
 			return err
 		}
 
-		err = swingsetkeeper.WaitUntilSwingStoreExportDone()
+		err = gaiaApp.SwingStoreExportsHandler.WaitUntilSwingStoreExportDone()
 		if err != nil {
 			return err
 		}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
 	"text/template"
 
@@ -33,6 +34,30 @@ func TestRootCmdConfig(t *testing.T) {
 	require.NoError(t, svrcmd.Execute(rootCmd, "", app.DefaultNodeHome))
 }
 
+func TestRootCmdMempoolVersion(t *testing.T) {
+	mempoolVersion := func(home string) string {
+		rootCmd, _ := cmd.NewRootCmd(nil)
+		rootCmd.SetArgs([]string{"config", "keyring-backend", "test", "--home", home})
+		require.NoError(t, svrcmd.Execute(rootCmd, "", home))
+		configCmd, _, err := rootCmd.Find([]string{"config"})
+		require.NoError(t, err)
+		return server.GetServerContextFromCmd(configCmd).Config.Mempool.Version
+	}
+
+	// A new home gets the prioritized mempool in its config.toml.
+	home := t.TempDir()
+	require.Equal(t, "v1", mempoolVersion(home))
+	config, err := os.ReadFile(filepath.Join(home, "config", "config.toml"))
+	require.NoError(t, err)
+	require.Contains(t, string(config), `version = "v1"`)
+
+	// An existing config.toml without a mempool version keeps the default one.
+	home = t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "config.toml"), []byte("moniker = \"node\"\n"), 0600))
+	require.Equal(t, "v0", mempoolVersion(home))
+}
+
 func TestCLIFlags(t *testing.T) {
 	// List of flags we have so far observed as used by the base cosmos sdk
 	// Before adding any flag to this list, the author should audit if explicit
@@ -0,0 +1,424 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset"
+	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	swingsettypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const (
+	// FlagGenesis is the command-line flag for the "swingstore verify" command
+	// specifying the genesis file holding the expected export data hash.
+	FlagGenesis = "genesis"
+)
+
+// SwingStoreCmd returns the command for operating on swing-store export
+// directories, like the swing-store directory of a genesis export-dir.
+func SwingStoreCmd(cdc codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swingstore",
+		Short: "Inspect, verify and compare swing-store export directories",
+	}
+	cmd.AddCommand(
+		swingStoreInspectCmd(),
+		swingStoreVerifyCmd(cdc),
+		swingStoreDiffCmd(),
+	)
+	return cmd
+}
+
+// forEachExportDataEntry calls fn with each entry of the "export data" of a
+// swing-store export, if any.
+func forEachExportDataEntry(provider swingsetkeeper.SwingStoreExportProvider, fn func(entry agoric.KVEntry) error) error {
+	reader, err := provider.GetExportDataReader()
+	if err != nil || reader == nil {
+		return err
+	}
+	defer reader.Close()
+	for {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+}
+
+// forEachArtifact calls fn with each artifact of a swing-store export.
+func forEachArtifact(provider swingsetkeeper.SwingStoreExportProvider, fn func(artifact swingsettypes.SwingStoreArtifact) error) error {
+	for {
+		artifact, err := provider.ReadNextArtifact()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := fn(artifact); err != nil {
+			return err
+		}
+	}
+}
+
+// exportDataKeyPrefix returns the part of an "export data" key before its
+// first dot, e.g. "bundle" or "transcript".
+func exportDataKeyPrefix(key string) string {
+	prefix, _, _ := strings.Cut(key, ".")
+	return prefix
+}
+
+func swingStoreInspectCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "inspect <dir>",
+		Short: "Print the content of a swing-store export directory",
+		Long: `Print the block height and artifacts of a swing-store export directory,
+with the size of each artifact and the number of "export data" keys by prefix.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider, err := swingsetkeeper.OpenSwingStoreExportDirectory(args[0])
+			if err != nil {
+				return err
+			}
+			cmd.Printf("block height: %d\n", provider.BlockHeight)
+
+			cmd.Println("artifacts:")
+			var artifactCount, totalSize int
+			err = forEachArtifact(provider, func(artifact swingsettypes.SwingStoreArtifact) error {
+				cmd.Printf("  %s: %d bytes\n", artifact.Name, len(artifact.Data))
+				artifactCount++
+				totalSize += len(artifact.Data)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			cmd.Printf("  total: %d artifacts, %d bytes\n", artifactCount, totalSize)
+
+			prefixCounts := map[string]int{}
+			entryCount := 0
+			err = forEachExportDataEntry(provider, func(entry agoric.KVEntry) error {
+				prefixCounts[exportDataKeyPrefix(entry.Key())]++
+				entryCount++
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			prefixes := make([]string, 0, len(prefixCounts))
+			for prefix := range prefixCounts {
+				prefixes = append(prefixes, prefix)
+			}
+			sort.Strings(prefixes)
+			cmd.Println("export data keys:")
+			for _, prefix := range prefixes {
+				cmd.Printf("  %s: %d\n", prefix, prefixCounts[prefix])
+			}
+			cmd.Printf("  total: %d\n", entryCount)
+			return nil
+		},
+	}
+}
+
+// exportDataHashFromGenesis returns the swing_store_export_data_hash of a
+// genesis file.
+func exportDataHashFromGenesis(cdc codec.Codec, genesisPath string) (string, error) {
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(genesisPath)
+	if err != nil {
+		return "", err
+	}
+	var genesisState swingsettypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[swingsettypes.ModuleName], &genesisState); err != nil {
+		return "", fmt.Errorf("cannot read the %s genesis of %s: %w", swingsettypes.ModuleName, genesisPath, err)
+	}
+	if genesisState.SwingStoreExportDataHash == "" {
+		return "", fmt.Errorf("%s has no swing-store export data hash", genesisPath)
+	}
+	return genesisState.SwingStoreExportDataHash, nil
+}
+
+func swingStoreVerifyCmd(cdc codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <dir>",
+		Short: "Verify the integrity of a swing-store export directory",
+		Long: `Verify the artifacts and "export data" of a swing-store export directory
+against the digests of its manifest, and the hash of its "export data" against
+the swing_store_export_data_hash of a genesis file. The genesis file defaults
+to the ` + ExportedGenesisFileName + ` of the parent directory, as created by the export
+command, if any.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			exportDir := args[0]
+			genesisPath, _ := cmd.Flags().GetString(FlagGenesis)
+			if genesisPath == "" {
+				defaultPath := filepath.Join(filepath.Dir(filepath.Clean(exportDir)), ExportedGenesisFileName)
+				if _, err := os.Stat(defaultPath); err == nil {
+					genesisPath = defaultPath
+				} else if !errors.Is(err, fs.ErrNotExist) {
+					return err
+				}
+			}
+
+			provider, err := swingsetkeeper.OpenSwingStoreExportDirectory(exportDir)
+			if err != nil {
+				return err
+			}
+			artifactCount := 0
+			err = forEachArtifact(provider, func(artifact swingsettypes.SwingStoreArtifact) error {
+				artifactCount++
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			cmd.Printf("verified %d artifacts\n", artifactCount)
+
+			reader, err := provider.GetExportDataReader()
+			if err != nil {
+				return err
+			}
+			if reader == nil {
+				return fmt.Errorf("swing-store export has no export data")
+			}
+			defer reader.Close()
+			hash, err := swingset.SwingStoreExportDataHash(reader)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("export data hash: %s\n", hash)
+
+			if genesisPath == "" {
+				cmd.Println("no genesis file to verify the export data hash against")
+				return nil
+			}
+			expectedHash, err := exportDataHashFromGenesis(cdc, genesisPath)
+			if err != nil {
+				return err
+			}
+			if hash != expectedHash {
+				return fmt.Errorf("export data hash %s doesn't match %s of %s", hash, expectedHash, genesisPath)
+			}
+			cmd.Printf("export data hash matches %s\n", genesisPath)
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagGenesis, "", "The genesis file holding the expected swing-store export data hash")
+	return cmd
+}
+
+// readArtifactHashesForDiff returns the SHA-256 hash of each artifact of a
+// swing-store export. Only the hashes are kept, since artifacts are listed in
+// no particular order.
+func readArtifactHashesForDiff(provider swingsetkeeper.SwingStoreExportProvider) (map[string][sha256.Size]byte, error) {
+	artifacts := map[string][sha256.Size]byte{}
+	err := forEachArtifact(provider, func(artifact swingsettypes.SwingStoreArtifact) error {
+		artifacts[artifact.Name] = sha256.Sum256(artifact.Data)
+		return nil
+	})
+	return artifacts, err
+}
+
+// sortedExportDataReader reads the "export data" entries of a swing-store
+// export, which are sorted by key, failing if they are not.
+type sortedExportDataReader struct {
+	reader  agoric.KVEntryReader
+	name    string
+	lastKey string
+	// entry is the current entry, valid unless done.
+	entry agoric.KVEntry
+	done  bool
+}
+
+// openSortedExportDataReader opens the "export data" of a swing-store export
+// and reads its first entry, if any.
+func openSortedExportDataReader(provider swingsetkeeper.SwingStoreExportProvider, name string) (*sortedExportDataReader, error) {
+	reader, err := provider.GetExportDataReader()
+	if err != nil {
+		return nil, err
+	}
+	if reader == nil {
+		reader = agoric.NewSwingStoreExportDataEntriesReader(nil)
+	}
+	sr := &sortedExportDataReader{reader: reader, name: name}
+	if err := sr.next(); err != nil {
+		reader.Close()
+		return nil, err
+	}
+	return sr, nil
+}
+
+// next reads the entry following the current one.
+func (sr *sortedExportDataReader) next() error {
+	entry, err := sr.reader.Read()
+	if err == io.EOF {
+		sr.done = true
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if sr.lastKey != "" && entry.Key() <= sr.lastKey {
+		return fmt.Errorf("export data of %s is not sorted: %s after %s", sr.name, entry.Key(), sr.lastKey)
+	}
+	sr.lastKey = entry.Key()
+	sr.entry = entry
+	return nil
+}
+
+func (sr *sortedExportDataReader) Close() error {
+	return sr.reader.Close()
+}
+
+// diffExportData merges the sorted "export data" of two swing-store exports,
+// calling report for each key added, removed or changed from the first to the
+// second.
+func diffExportData(readerA, readerB *sortedExportDataReader, report func(key string, entryA, entryB *agoric.KVEntry) error) error {
+	for !readerA.done || !readerB.done {
+		var entryA, entryB *agoric.KVEntry
+		switch {
+		case readerB.done || (!readerA.done && readerA.entry.Key() < readerB.entry.Key()):
+			entryA = &readerA.entry
+		case readerA.done || readerB.entry.Key() < readerA.entry.Key():
+			entryB = &readerB.entry
+		default:
+			entryA, entryB = &readerA.entry, &readerB.entry
+		}
+
+		var key string
+		if entryA != nil {
+			key = entryA.Key()
+		} else {
+			key = entryB.Key()
+		}
+		if entryA == nil || entryB == nil || entryA.HasValue() != entryB.HasValue() || entryA.StringValue() != entryB.StringValue() {
+			if err := report(key, entryA, entryB); err != nil {
+				return err
+			}
+		}
+
+		if entryA != nil {
+			if err := readerA.next(); err != nil {
+				return err
+			}
+		}
+		if entryB != nil {
+			if err := readerB.next(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sortedUnion returns the sorted keys present in either map.
+func sortedUnion[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func swingStoreDiffCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff <dirA> <dirB>",
+		Short: "Compare two swing-store export directories",
+		Long: `Report the "export data" keys added, removed or changed from the first
+swing-store export directory to the second, and the artifacts present in only
+one of them or with a different content. Fails if the exports differ. The
+"export data" of both exports must be sorted by key, as it is when exported.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			providerA, err := swingsetkeeper.OpenSwingStoreExportDirectory(args[0])
+			if err != nil {
+				return err
+			}
+			providerB, err := swingsetkeeper.OpenSwingStoreExportDirectory(args[1])
+			if err != nil {
+				return err
+			}
+
+			// The "export data" may be large, so it is compared by merging the
+			// entries of both exports, which are sorted by key.
+			readerA, err := openSortedExportDataReader(providerA, args[0])
+			if err != nil {
+				return err
+			}
+			defer readerA.Close()
+			readerB, err := openSortedExportDataReader(providerB, args[1])
+			if err != nil {
+				return err
+			}
+			defer readerB.Close()
+
+			differences := 0
+			err = diffExportData(readerA, readerB, func(key string, entryA, entryB *agoric.KVEntry) error {
+				switch {
+				case entryA == nil:
+					cmd.Printf("export data + %s: %s\n", key, entryB.StringValue())
+				case entryB == nil:
+					cmd.Printf("export data - %s: %s\n", key, entryA.StringValue())
+				default:
+					cmd.Printf("export data ~ %s: %s -> %s\n", key, entryA.StringValue(), entryB.StringValue())
+				}
+				differences++
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			artifactsA, err := readArtifactHashesForDiff(providerA)
+			if err != nil {
+				return err
+			}
+			artifactsB, err := readArtifactHashesForDiff(providerB)
+			if err != nil {
+				return err
+			}
+			for _, name := range sortedUnion(artifactsA, artifactsB) {
+				hashA, inA := artifactsA[name]
+				hashB, inB := artifactsB[name]
+				switch {
+				case !inA:
+					cmd.Printf("artifact + %s\n", name)
+				case !inB:
+					cmd.Printf("artifact - %s\n", name)
+				case hashA != hashB:
+					cmd.Printf("artifact ~ %s: sha256 %x -> %x\n", name, hashA, hashB)
+				default:
+					continue
+				}
+				differences++
+			}
+
+			if differences > 0 {
+				return fmt.Errorf("swing-store exports differ in %d places", differences)
+			}
+			cmd.Println("swing-store exports are identical")
+			return nil
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package cmd_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	app "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/daemon/cmd"
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset"
+	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	swingsettypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+func writeSwingStoreExport(t *testing.T, exportData []*swingsettypes.SwingStoreExportDataEntry, artifacts []swingsettypes.SwingStoreArtifact) string {
+	exportDir := filepath.Join(t.TempDir(), "swing-store")
+	require.NoError(t, os.Mkdir(exportDir, 0755))
+	provider := swingsetkeeper.SwingStoreExportProvider{
+		BlockHeight: 42,
+		GetExportDataReader: func() (agoric.KVEntryReader, error) {
+			return agoric.NewSwingStoreExportDataEntriesReader(exportData), nil
+		},
+		ReadNextArtifact: func() (swingsettypes.SwingStoreArtifact, error) {
+			if len(artifacts) == 0 {
+				return swingsettypes.SwingStoreArtifact{}, io.EOF
+			}
+			artifact := artifacts[0]
+			artifacts = artifacts[1:]
+			return artifact, nil
+		},
+	}
+	require.NoError(t, swingsetkeeper.WriteSwingStoreExportToDirectory(provider, exportDir))
+	return exportDir
+}
+
+func runSwingStoreCmd(args ...string) (string, error) {
+	swingStoreCmd := cmd.SwingStoreCmd(app.MakeEncodingConfig().Marshaler)
+	var out bytes.Buffer
+	swingStoreCmd.SetOut(&out)
+	swingStoreCmd.SetErr(io.Discard)
+	swingStoreCmd.SetArgs(args)
+	err := swingStoreCmd.Execute()
+	return out.String(), err
+}
+
+func TestSwingStoreCmd(t *testing.T) {
+	exportData := []*swingsettypes.SwingStoreExportDataEntry{
+		{Key: "bundle.b1-1234", Value: "1234"},
+		{Key: "transcript.v1.1", Value: "{}"},
+		{Key: "transcript.v1.current", Value: "{}"},
+	}
+	artifacts := []swingsettypes.SwingStoreArtifact{
+		{Name: "bundle.b1-1234", Data: []byte("bundle data")},
+		{Name: "transcript.v1.1.2", Data: []byte("transcript data")},
+	}
+	exportDirA := writeSwingStoreExport(t, exportData, artifacts)
+
+	out, err := runSwingStoreCmd("inspect", exportDirA)
+	require.NoError(t, err)
+	require.Contains(t, out, "block height: 42")
+	require.Contains(t, out, "transcript.v1.1.2: 15 bytes")
+	require.Contains(t, out, "transcript: 2")
+
+	// Without a genesis file, only the digests of the manifest are verified.
+	out, err = runSwingStoreCmd("verify", exportDirA)
+	require.NoError(t, err)
+	require.Contains(t, out, "verified 2 artifacts")
+
+	hash, err := swingset.SwingStoreExportDataHash(agoric.NewSwingStoreExportDataEntriesReader(exportData))
+	require.NoError(t, err)
+	genesisPath := filepath.Join(filepath.Dir(exportDirA), cmd.ExportedGenesisFileName)
+	writeGenesis := func(hash string) {
+		genesis := fmt.Sprintf(`{"chain_id":"agoriclocal","app_state":{"swingset":{"swing_store_export_data_hash":%q}}}`, hash)
+		require.NoError(t, os.WriteFile(genesisPath, []byte(genesis), 0644))
+	}
+	writeGenesis(hash)
+	out, err = runSwingStoreCmd("verify", exportDirA)
+	require.NoError(t, err)
+	require.Contains(t, out, "export data hash matches")
+
+	writeGenesis("sha256:0000")
+	_, err = runSwingStoreCmd("verify", exportDirA, "--genesis", genesisPath)
+	require.ErrorContains(t, err, "doesn't match")
+
+	out, err = runSwingStoreCmd("diff", exportDirA, exportDirA)
+	require.NoError(t, err)
+	require.Contains(t, out, "identical")
+
+	exportDirB := writeSwingStoreExport(t,
+		[]*swingsettypes.SwingStoreExportDataEntry{exportData[0], {Key: "transcript.v1.1", Value: `{"changed":true}`}},
+		[]swingsettypes.SwingStoreArtifact{artifacts[0], {Name: "transcript.v1.1.2", Data: []byte("other data")}},
+	)
+	out, err = runSwingStoreCmd("diff", exportDirA, exportDirB)
+	require.ErrorContains(t, err, "differ in 3 places")
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Equal(t, []string{
+		`export data ~ transcript.v1.1: {} -> {"changed":true}`,
+		`export data - transcript.v1.current: {}`,
+	}, lines[:2])
+	require.True(t, strings.HasPrefix(lines[2], "artifact ~ transcript.v1.1.2: sha256 "))
+
+	out, err = runSwingStoreCmd("diff", exportDirB, exportDirA)
+	require.ErrorContains(t, err, "differ in 3 places")
+	require.Contains(t, out, "export data + transcript.v1.current: {}")
+
+	// The export data is compared by merging entries sorted by key.
+	exportDirUnsorted := writeSwingStoreExport(t,
+		[]*swingsettypes.SwingStoreExportDataEntry{exportData[1], exportData[0]},
+		artifacts,
+	)
+	_, err = runSwingStoreCmd("diff", exportDirA, exportDirUnsorted)
+	require.ErrorContains(t, err, "is not sorted")
+}
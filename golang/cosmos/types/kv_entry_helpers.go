@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bufio"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -49,6 +51,12 @@ import (
 //   each line is [key, value] if the KVEntry has a value, and [key] otherwise.
 //   This format terminates each line, but is still compatible with JSON Lines
 //   (which is line feed *separated*) for Go and JS decoders.
+// - NewBinaryKVEntryDecoderReader and EncodeKVEntryReaderToBinary are the
+//   equivalents for a more compact length-prefixed binary encoding, which
+//   avoids the cost of JSON escaping and parsing. Each KVEntry is encoded as
+//   the uvarint length of the key followed by the key, then either a 0 byte if
+//   the entry has no value, or the uvarint of the value length plus one
+//   followed by the value.
 
 // KVEntryReader is an abstraction for iteratively reading KVEntry data.
 type KVEntryReader interface {
@@ -233,6 +241,115 @@ func EncodeKVEntryReaderToJsonl(reader KVEntryReader, bytesWriter io.Writer) (er
 	}
 }
 
+// maxBinaryKVEntryFieldLength bounds the length of a key or value decoded by
+// a binaryKVEntryDecoderReader, to fail early on corrupted input.
+const maxBinaryKVEntryFieldLength = 1 << 30
+
+var _ KVEntryReader = &binaryKVEntryDecoderReader{}
+
+// binaryKVEntryDecoderReader is the KVEntryReader decoding length-prefixed
+// binary encoded key/value pairs.
+type binaryKVEntryDecoderReader struct {
+	closer io.Closer
+	reader *bufio.Reader
+}
+
+// readField reads a key or value of the given length.
+func (reader binaryKVEntryDecoderReader) readField(length uint64) (string, error) {
+	if length > maxBinaryKVEntryFieldLength {
+		return "", fmt.Errorf("binary KVEntry field length %d exceeds %d", length, maxBinaryKVEntryFieldLength)
+	}
+	field := make([]byte, length)
+	if _, err := io.ReadFull(reader.reader, field); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return "", err
+	}
+	return string(field), nil
+}
+
+// Read yields the next decoded KVEntry
+// Implements KVEntryReader
+func (reader binaryKVEntryDecoderReader) Read() (next KVEntry, err error) {
+	keyLength, err := binary.ReadUvarint(reader.reader)
+	if err != nil {
+		// io.EOF only if the stream ends between entries
+		return KVEntry{}, err
+	}
+	key, err := reader.readField(keyLength)
+	if err != nil {
+		return KVEntry{}, err
+	}
+	valueLength, err := binary.ReadUvarint(reader.reader)
+	if err == io.EOF {
+		return KVEntry{}, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return KVEntry{}, err
+	}
+
+	if valueLength == 0 {
+		next = NewKVEntryWithNoValue(key)
+	} else {
+		value, err := reader.readField(valueLength - 1)
+		if err != nil {
+			return KVEntry{}, err
+		}
+		next = NewKVEntry(key, value)
+	}
+	if !next.IsValidKey() {
+		return KVEntry{}, fmt.Errorf("decoded a KVEntry with an invalid key")
+	}
+	return next, nil
+}
+
+// Close release the underlying resource backing the decoder
+// Implements KVEntryReader
+func (reader binaryKVEntryDecoderReader) Close() error {
+	return reader.closer.Close()
+}
+
+// NewBinaryKVEntryDecoderReader creates a KVEntryReader over a byte stream
+// reader that decodes length-prefixed binary encoded KVEntry. The entries are
+// yielded in order they're present in the stream.
+func NewBinaryKVEntryDecoderReader(byteReader io.ReadCloser) KVEntryReader {
+	return &binaryKVEntryDecoderReader{
+		closer: byteReader,
+		reader: bufio.NewReader(byteReader),
+	}
+}
+
+// EncodeKVEntryReaderToBinary consumes a KVEntryReader and encodes each
+// KVEntry as a length-prefixed binary record.
+// It will not Close the Reader when done
+func EncodeKVEntryReaderToBinary(reader KVEntryReader, bytesWriter io.Writer) (err error) {
+	writer := bufio.NewWriter(bytesWriter)
+	var lengthBytes [binary.MaxVarintLen64]byte
+	writeField := func(lengthPrefix uint64, field string) {
+		n := binary.PutUvarint(lengthBytes[:], lengthPrefix)
+		// bufio.Writer errors are sticky and reported by Flush
+		_, _ = writer.Write(lengthBytes[:n])
+		_, _ = writer.WriteString(field)
+	}
+	for {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			return writer.Flush()
+		} else if err != nil {
+			return err
+		}
+
+		key := entry.Key()
+		writeField(uint64(len(key)), key)
+		if entry.HasValue() {
+			value := entry.StringValue()
+			writeField(uint64(len(value))+1, value)
+		} else {
+			writeField(0, "")
+		}
+	}
+}
+
 var _ KVEntryReader = &kvHookingReader{}
 
 // kvHookingReader is a KVEntryReader backed by another KVEntryReader which
@@ -235,3 +235,46 @@ func TestJsonlEncodeAndReadBack(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestBinaryEncodeAndReadBack(t *testing.T) {
+	source := []KVEntry{NewKVEntry("foo", "bar"), NewKVEntryWithNoValue("baz"), NewKVEntry("empty", ""), NewKVEntry("quote", "\"<\n>\"")}
+	sourceReader := &kvEntriesReader[KVEntry]{entries: source, toKVEntry: toKVEntryIdentity}
+
+	var encodedKVEntries bytes.Buffer
+	err := EncodeKVEntryReaderToBinary(sourceReader, &encodedKVEntries)
+	if err != nil {
+		t.Errorf("unexpected encode error %v", err)
+	}
+	encoded := encodedKVEntries.Bytes()
+
+	binaryReader := NewBinaryKVEntryDecoderReader(io.NopCloser(bytes.NewReader(encoded)))
+	for _, expected := range source {
+		got, err := binaryReader.Read()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		checkSameKVEntry(t, got, expected)
+	}
+
+	_, err = binaryReader.Read()
+	if err != io.EOF {
+		t.Errorf("expected error io.EOF, got %v", err)
+	}
+
+	err = binaryReader.Close()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// A truncated stream is not mistaken for its end.
+	truncatedReader := NewBinaryKVEntryDecoderReader(io.NopCloser(bytes.NewReader(encoded[:len(encoded)-1])))
+	for i := 0; i < len(source)-1; i++ {
+		if _, err := truncatedReader.Read(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	_, err = truncatedReader.Read()
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("expected error io.ErrUnexpectedEOF, got %v", err)
+	}
+}
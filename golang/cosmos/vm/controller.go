@@ -24,6 +24,48 @@ type ControllerAdmissionMsg interface {
 	IsHighPriority(sdk.Context, interface{}) (bool, error)
 }
 
+// feeGrantContextKey is the context key of the fee grant used by the Tx whose
+// messages are being admitted.
+type feeGrantContextKey struct{}
+
+type feeGrant struct {
+	granter sdk.AccAddress
+	msgs    []sdk.Msg
+}
+
+// WithFeeGrant returns a context recording that the fees of the given messages
+// are paid by a feegrant allowance from the granter, so that the controller can
+// draw its own charges for admitting the messages from the same allowance.
+func WithFeeGrant(ctx sdk.Context, granter sdk.AccAddress, msgs []sdk.Msg) sdk.Context {
+	return ctx.WithValue(feeGrantContextKey{}, feeGrant{granter: granter, msgs: msgs})
+}
+
+// FeeGrant returns the granter and the messages recorded by WithFeeGrant, or a
+// nil granter if the fees are not paid by a feegrant allowance.
+func FeeGrant(ctx sdk.Context) (sdk.AccAddress, []sdk.Msg) {
+	grant, ok := ctx.Value(feeGrantContextKey{}).(feeGrant)
+	if !ok {
+		return nil, nil
+	}
+	return grant.granter, grant.msgs
+}
+
+// feeDenomContextKey is the context key of the denom chosen to settle the
+// controller's charges for admitting the messages of a Tx.
+type feeDenomContextKey struct{}
+
+// WithFeeDenom returns a context recording the denom chosen by the payer to
+// settle the controller's charges for admitting the messages of the Tx.
+func WithFeeDenom(ctx sdk.Context, denom string) sdk.Context {
+	return ctx.WithValue(feeDenomContextKey{}, denom)
+}
+
+// FeeDenom returns the denom recorded by WithFeeDenom, or "" if none.
+func FeeDenom(ctx sdk.Context) string {
+	denom, _ := ctx.Value(feeDenomContextKey{}).(string)
+	return denom
+}
+
 type PortHandler interface {
 	Receive(context.Context, string) (string, error)
 }
@@ -2,8 +2,8 @@ package swingset
 
 import (
 	// "os"
-	"context"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -50,14 +50,13 @@ func BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, keeper Keeper) erro
 	return err
 }
 
-var endBlockHeight int64
-var endBlockTime int64
-
 func EndBlock(ctx sdk.Context, req abci.RequestEndBlock, keeper Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	keeper.PruneExpiredBundleUploads(ctx)
+
 	action := endBlockAction{}
-	_, err := keeper.BlockingSend(ctx, action)
+	out, err := keeper.BlockingSend(ctx, action)
 
 	// fmt.Fprintf(os.Stderr, "END_BLOCK Returned from SwingSet: %s, %v\n", out, err)
 	if err != nil {
@@ -66,25 +65,46 @@ func EndBlock(ctx sdk.Context, req abci.RequestEndBlock, keeper Keeper) ([]abci.
 		panic(err)
 	}
 
+	stats, found, err := keeper.RecordEndBlockReply(ctx, out)
+	if err != nil {
+		keeper.Logger(ctx).Error("failed to record END_BLOCK stats", "error", err)
+	} else if found {
+		setBlockStatsGauges(stats)
+	}
+
+	// Forget the senders of the actions consumed by the controller.
+	err = keeper.PruneInboundSenders(ctx)
+	if err != nil {
+		keeper.Logger(ctx).Error("failed to prune inbound senders", "error", err)
+	}
+
 	// Save our EndBlock status.
-	endBlockHeight = ctx.BlockHeight()
-	endBlockTime = ctx.BlockTime().Unix()
+	keeper.SetEndBlockContext(ctx)
 
 	return []abci.ValidatorUpdate{}, nil
 }
 
-func getEndBlockContext() sdk.Context {
-	return sdk.Context{}.
-		WithContext(context.Background()).
-		WithBlockHeight(endBlockHeight).
-		WithBlockTime(time.Unix(endBlockTime, 0))
+// setBlockStatsGauges reports the execution statistics of the block as
+// telemetry gauges.
+func setBlockStatsGauges(stats types.BlockStats) {
+	computrons, _ := new(big.Float).SetInt(stats.Computrons.BigInt()).Float32()
+	telemetry.SetGauge(computrons, types.ModuleName, "block_computrons")
+	beansUsed, _ := new(big.Float).SetInt(stats.BeansUsed.BigInt()).Float32()
+	telemetry.SetGauge(beansUsed, types.ModuleName, "block_beans_used")
+	telemetry.SetGauge(float32(stats.Cranks), types.ModuleName, "block_cranks")
+	telemetry.SetGauge(float32(stats.InboundProcessed), types.ModuleName, "block_inbound_processed")
+	stoppedEarly := float32(0)
+	if stats.StoppedEarly {
+		stoppedEarly = 1
+	}
+	telemetry.SetGauge(stoppedEarly, types.ModuleName, "block_stopped_early")
 }
 
 func CommitBlock(keeper Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), "commit_blocker")
 
 	action := commitBlockAction{}
-	_, err := keeper.BlockingSend(getEndBlockContext(), action)
+	_, err := keeper.BlockingSend(keeper.GetEndBlockContext(), action)
 
 	// fmt.Fprintf(os.Stderr, "COMMIT_BLOCK Returned from SwingSet: %s, %v\n", out, err)
 	if err != nil {
@@ -99,7 +119,7 @@ func AfterCommitBlock(keeper Keeper) error {
 	// defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), "commit_blocker")
 
 	action := afterCommitBlockAction{}
-	_, err := keeper.BlockingSend(getEndBlockContext(), action)
+	_, err := keeper.BlockingSend(keeper.GetEndBlockContext(), action)
 
 	// fmt.Fprintf(os.Stderr, "AFTER_COMMIT_BLOCK Returned from SwingSet: %s, %v\n", out, err)
 	if err != nil {
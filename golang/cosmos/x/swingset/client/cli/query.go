@@ -1,7 +1,14 @@
 package cli
 
 import (
+	"encoding/csv"
+	"os"
+	"strconv"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -9,6 +16,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagPowerFlag = "power-flag"
+	FlagCSV       = "csv"
+)
+
 func GetQueryCmd(storeKey string) *cobra.Command {
 	swingsetQueryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -21,6 +33,15 @@ func GetQueryCmd(storeKey string) *cobra.Command {
 		GetCmdGetEgress(storeKey),
 		GetCmdQueryParams(storeKey),
 		GetCmdMailbox(storeKey),
+		GetCmdEgresses(storeKey),
+		GetCmdMailboxes(storeKey),
+		GetCmdCoreEvalOutcome(storeKey),
+		GetCmdBundles(storeKey),
+		GetCmdBundle(storeKey),
+		GetCmdInboundPause(storeKey),
+		GetCmdBlockStats(storeKey),
+		GetCmdExportDataCheck(storeKey),
+		GetCmdEstimateBeans(storeKey),
 	)
 
 	return swingsetQueryCmd
@@ -115,3 +136,397 @@ func GetCmdMailbox(queryRoute string) *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdEgresses lists the provisioned egresses
+func GetCmdEgresses(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "egresses",
+		Short: "list provisioned egresses",
+		Long: `list provisioned egresses, optionally only those with a power flag.
+With --csv, all the egresses from the requested page on are written as CSV
+rows of peer, nickname and semicolon-separated power flags.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			powerFlag, err := cmd.Flags().GetString(FlagPowerFlag)
+			if err != nil {
+				return err
+			}
+			asCSV, err := cmd.Flags().GetBool(FlagCSV)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEgressesRequest{
+				PowerFlag:  powerFlag,
+				Pagination: pageReq,
+			}
+			if !asCSV {
+				res, err := queryClient.Egresses(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			w := csv.NewWriter(cmd.OutOrStdout())
+			if err := w.Write([]string{"peer", "nickname", "power_flags"}); err != nil {
+				return err
+			}
+			for {
+				res, err := queryClient.Egresses(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				for _, egress := range res.Egresses {
+					row := []string{egress.Peer.String(), egress.Nickname, strings.Join(egress.PowerFlags, ";")}
+					if err := w.Write(row); err != nil {
+						return err
+					}
+				}
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				req.Pagination = nextPageRequest(req.Pagination, res.Pagination)
+			}
+			w.Flush()
+			return w.Error()
+		},
+	}
+
+	cmd.Flags().String(FlagPowerFlag, "", "Only list the egresses with this power flag")
+	cmd.Flags().Bool(FlagCSV, false, "Write all the egresses as CSV")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "egresses")
+	return cmd
+}
+
+// GetCmdMailboxes lists the outbound mailboxes
+func GetCmdMailboxes(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mailboxes",
+		Short: "list outbound mailboxes",
+		Long: `list outbound mailboxes.
+With --csv, all the mailboxes from the requested page on are written as CSV
+rows of peer and mailbox JSON.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			asCSV, err := cmd.Flags().GetBool(FlagCSV)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMailboxesRequest{
+				Pagination: pageReq,
+			}
+			if !asCSV {
+				res, err := queryClient.Mailboxes(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			w := csv.NewWriter(cmd.OutOrStdout())
+			if err := w.Write([]string{"peer", "value"}); err != nil {
+				return err
+			}
+			for {
+				res, err := queryClient.Mailboxes(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				for _, mailbox := range res.Mailboxes {
+					if err := w.Write([]string{mailbox.Peer, mailbox.Value}); err != nil {
+						return err
+					}
+				}
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				req.Pagination = nextPageRequest(req.Pagination, res.Pagination)
+			}
+			w.Flush()
+			return w.Error()
+		},
+	}
+
+	cmd.Flags().Bool(FlagCSV, false, "Write all the mailboxes as CSV")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mailboxes")
+	return cmd
+}
+
+// nextPageRequest returns the request for the page following a response,
+// with the same limit.
+func nextPageRequest(pageReq *query.PageRequest, pageRes *query.PageResponse) *query.PageRequest {
+	return &query.PageRequest{
+		Key:     pageRes.NextKey,
+		Limit:   pageReq.Limit,
+		Reverse: pageReq.Reverse,
+	}
+}
+
+// GetCmdCoreEvalOutcome queries the outcome of a governance MsgCoreEval
+func GetCmdCoreEvalOutcome(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "core-eval-outcome <proposal-id>",
+		Short: "get the outcome of the core evals of a governance proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CoreEvalOutcome(cmd.Context(), &types.QueryCoreEvalOutcomeRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Outcome)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBundles queries the installed bundles
+func GetCmdBundles(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundles",
+		Short: "list installed bundles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Bundles(cmd.Context(), &types.QueryBundlesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bundles")
+	return cmd
+}
+
+// GetCmdBundle queries an installed bundle
+func GetCmdBundle(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle <bundle-id>",
+		Short: "get installation info for a bundle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Bundle(cmd.Context(), &types.QueryBundleRequest{
+				BundleId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Bundle)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdInboundPause queries the governance pause of inbound messages
+func GetCmdInboundPause(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inbound-pause",
+		Short: "get the governance pause of inbound messages",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InboundPause(cmd.Context(), &types.QueryInboundPauseRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBlockStats queries the recorded execution statistics of recent blocks
+func GetCmdBlockStats(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-stats [min-height] [max-height]",
+		Short: "get the recorded VM execution statistics of recent blocks",
+		Long: `Get the VM execution statistics recorded for recent blocks, optionally
+limited to the blocks between min-height and max-height inclusive.`,
+		Args: cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBlockStatsRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				if req.MinHeight, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return err
+				}
+			}
+			if len(args) > 1 {
+				if req.MaxHeight, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.BlockStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "block-stats")
+	return cmd
+}
+
+// GetCmdExportDataCheck queries the latest check of the swing-store export data
+func GetCmdExportDataCheck(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-data-check",
+		Short: "get the latest check of the swing-store export data by the node",
+		Long: `Get the outcome of the latest comparison by the queried node of the
+swing-store export data replicated in the swingset store with the export data
+of the VM, along with the number of mismatches found since the node started.
+The checks are enabled by the swingset.export_data_check_interval option.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExportDataCheck(cmd.Context(), &types.QueryExportDataCheckRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdEstimateBeans estimates the bean charges of the messages of a Tx
+func GetCmdEstimateBeans(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-beans <tx-json-file>",
+		Short: "estimate the bean charges of the messages of a Tx, and their cost in each fee denom",
+		Long: `Estimate the bean charges of the messages of a Tx, and their cost in each
+denom of the fee unit price. The Tx is read from a JSON file, such as the output
+of a tx command with the --generate-only flag.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			tx, err := clientCtx.TxConfig.TxJSONDecoder()(bz)
+			if err != nil {
+				return err
+			}
+			msgs := tx.GetMsgs()
+			anys := make([]*codectypes.Any, len(msgs))
+			for i, msg := range msgs {
+				anys[i], err = codectypes.NewAnyWithValue(msg)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.EstimateBeans(cmd.Context(), &types.QueryEstimateBeansRequest{
+				Msgs: anys,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
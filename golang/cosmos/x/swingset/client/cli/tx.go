@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -22,6 +23,8 @@ import (
 const (
 	FlagAllowSpend = "allow-spend"
 	FlagCompress   = "compress"
+	FlagAdd        = "add"
+	FlagRemove     = "remove"
 )
 
 func GetTxCmd(storeKey string) *cobra.Command {
@@ -36,8 +39,12 @@ func GetTxCmd(storeKey string) *cobra.Command {
 	swingsetTxCmd.AddCommand(
 		GetCmdDeliver(),
 		GetCmdProvisionOne(),
+		GetCmdUpdatePowerFlags(),
+		GetCmdDeprovision(),
 		GetCmdInstallBundle(),
 		GetCmdWalletAction(),
+		GetCmdWalletOffer(),
+		GetCmdWalletActionBatch(),
 	)
 
 	return swingsetTxCmd
@@ -196,6 +203,85 @@ func GetCmdProvisionOne() *cobra.Command {
 	return cmd
 }
 
+// GetCmdUpdatePowerFlags is the CLI command for adding or removing power flags
+// of a provisioned address.
+func GetCmdUpdatePowerFlags() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-power-flags <address> [--add <power-flag>[,...]] [--remove <power-flag>[,...]]",
+		Short: "add or remove power flags of a provisioned address",
+		Args:  cobra.ExactArgs(1),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			addPowerFlags, err := cmd.Flags().GetStringSlice(FlagAdd)
+			if err != nil {
+				return err
+			}
+			removePowerFlags, err := cmd.Flags().GetStringSlice(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateEgressPowerFlags(addr, addPowerFlags, removePowerFlags, cctx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cctx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAdd, nil, "Power flags to add")
+	cmd.Flags().StringSlice(FlagRemove, nil, "Power flags to remove")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDeprovision is the CLI command for deprovisioning an address.
+func GetCmdDeprovision() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprovision <address> [<reason>]",
+		Short: "deprovision an address",
+		Args:  cobra.RangeArgs(1, 2),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var reason string
+			if len(args) > 1 {
+				reason = args[1]
+			}
+
+			msg := types.NewMsgDeprovision(addr, reason, cctx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdWalletAction is the CLI command for sending a WalletAction or WalletSpendAction transaction
 func GetCmdWalletAction() *cobra.Command {
 	cmd := &cobra.Command{
@@ -234,6 +320,89 @@ func GetCmdWalletAction() *cobra.Command {
 	return cmd
 }
 
+// GetCmdWalletActionBatch is the CLI command for sending a WalletActionBatch
+// transaction
+func GetCmdWalletActionBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet-action-batch {<actions JSON> | @- | @<file>}",
+		Short: "perform a batch of wallet actions",
+		Long: `perform a batch of wallet actions.
+The argument indicates how to read input JSON ("@-" for standard input,
+"@..." for a file path, and otherwise directly as in
+"wallet-action-batch '[...]'").
+Input must represent an array of {"action": string, "spend": boolean}
+objects, in which each action is JSON-stringified marshalled data.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			jsonIn := args[0]
+			if strings.HasPrefix(jsonIn, "@") {
+				var jsonBytes []byte
+				fname := jsonIn[1:]
+				if fname == "-" {
+					jsonBytes, err = io.ReadAll(os.Stdin)
+				} else {
+					jsonBytes, err = os.ReadFile(fname)
+				}
+				if err != nil {
+					return err
+				}
+				jsonIn = string(jsonBytes)
+			}
+			var actions []types.WalletBatchAction
+			if err := json.Unmarshal([]byte(jsonIn), &actions); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWalletActionBatch(clientCtx.GetFromAddress(), actions)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdWalletOffer is the CLI command for sending a WalletOffer transaction
+func GetCmdWalletOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet-offer <offer id> <invitation spec CapData> <proposal CapData> [<offer args CapData>]",
+		Short: "execute a smart wallet offer",
+		Long: `execute a smart wallet offer.
+The invitation spec, proposal and optional offer args are each JSON-stringified
+CapData in the smallcaps encoding, and are validated before sending.`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var offerArgs string
+			if len(args) > 3 {
+				offerArgs = args[3]
+			}
+			msg := types.NewMsgWalletOffer(clientCtx.GetFromAddress(), args[0], args[1], args[2], offerArgs)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdSubmitCoreEvalProposal is the CLI command for submitting a "CoreEval"
 // governance proposal via `agd tx gov submit-proposal swingset-core-eval ...`.
 func NewCmdSubmitCoreEvalProposal() *cobra.Command {
@@ -0,0 +1,124 @@
+package swingset
+
+import (
+	"fmt"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+)
+
+const (
+	ConfigPrefix                    = "swingset"
+	FlagSnapshotCompressionLevel    = ConfigPrefix + ".snapshot_compression_level"
+	FlagSnapshotArtifactMode        = ConfigPrefix + ".snapshot_artifact_mode"
+	FlagSnapshotRestoreArtifactMode = ConfigPrefix + ".snapshot_restore_artifact_mode"
+	FlagExportDataCheckInterval     = ConfigPrefix + ".export_data_check_interval"
+	minSnapshotCompressionLevel     = 1
+	maxSnapshotCompressionLevel     = 22
+	defaultSnapshotCompressionLevel = keeper.DefaultSnapshotCompressionLevel
+)
+
+// DefaultConfigTemplate is the app.toml section of the swingset configuration,
+// to be appended to the server config template.
+const DefaultConfigTemplate = `
+###############################################################################
+###                         SwingSet Configuration                          ###
+###############################################################################
+
+[swingset]
+# The zstd compression level, from 1 (fastest) to 22 (smallest), of the
+# SwingStore artifacts included in state-sync snapshots.
+snapshot_compression_level = {{ .Swingset.SnapshotCompressionLevel }}
+
+# The set of SwingStore artifacts included in the state-sync snapshots created
+# by this node: "operational", "replay" or "archival". The swing-store of this
+# node must have retained the artifacts of the chosen set.
+snapshot_artifact_mode = "{{ .Swingset.SnapshotArtifactMode }}"
+
+# The set of SwingStore artifacts restored from a state-sync snapshot by this
+# node: "operational", "replay" or "archival". Snapshots that don't include
+# this set are rejected, and any additional artifacts are not restored.
+snapshot_restore_artifact_mode = "{{ .Swingset.SnapshotRestoreArtifactMode }}"
+
+# The interval in blocks between checks that the SwingStore export data
+# replicated in the swingset store matches the export data of the VM, or 0 to
+# disable the checks. Each check hashes the whole export data of both sides
+# after the block is committed, and is skipped while the previous one runs.
+export_data_check_interval = {{ .Swingset.ExportDataCheckInterval }}
+`
+
+// SwingsetConfig defines the app.toml configuration of the swingset module.
+type SwingsetConfig struct {
+	// SnapshotCompressionLevel is the zstd compression level of the SwingStore
+	// artifacts included in state-sync snapshots.
+	SnapshotCompressionLevel int `mapstructure:"snapshot_compression_level"`
+	// SnapshotArtifactMode is the set of SwingStore artifacts included in
+	// state-sync snapshots.
+	SnapshotArtifactMode string `mapstructure:"snapshot_artifact_mode"`
+	// SnapshotRestoreArtifactMode is the set of SwingStore artifacts restored
+	// from state-sync snapshots, which must include them.
+	SnapshotRestoreArtifactMode string `mapstructure:"snapshot_restore_artifact_mode"`
+	// ExportDataCheckInterval is the interval in blocks between checks of the
+	// SwingStore export data replicated in the swingset store, or 0 if disabled.
+	ExportDataCheckInterval int64 `mapstructure:"export_data_check_interval"`
+}
+
+// DefaultSwingsetConfig is the swingset configuration used if app.toml
+// doesn't specify any.
+var DefaultSwingsetConfig = SwingsetConfig{
+	SnapshotCompressionLevel:    defaultSnapshotCompressionLevel,
+	SnapshotArtifactMode:        keeper.SwingStoreArtifactModeOperational,
+	SnapshotRestoreArtifactMode: keeper.SwingStoreArtifactModeOperational,
+}
+
+// SwingsetConfigFromViper returns the swingset configuration from the app
+// options, defaulting any missing value.
+func SwingsetConfigFromViper(appOpts servertypes.AppOptions) (*SwingsetConfig, error) {
+	config := DefaultSwingsetConfig
+
+	if level := appOpts.Get(FlagSnapshotCompressionLevel); level != nil {
+		var err error
+		config.SnapshotCompressionLevel, err = cast.ToIntE(level)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", FlagSnapshotCompressionLevel, err)
+		}
+	}
+	if config.SnapshotCompressionLevel < minSnapshotCompressionLevel || config.SnapshotCompressionLevel > maxSnapshotCompressionLevel {
+		return nil, fmt.Errorf("%s must be between %d and %d, got %d",
+			FlagSnapshotCompressionLevel, minSnapshotCompressionLevel, maxSnapshotCompressionLevel, config.SnapshotCompressionLevel)
+	}
+
+	for _, artifactMode := range []struct {
+		flag  string
+		value *string
+	}{
+		{FlagSnapshotArtifactMode, &config.SnapshotArtifactMode},
+		{FlagSnapshotRestoreArtifactMode, &config.SnapshotRestoreArtifactMode},
+	} {
+		if mode := appOpts.Get(artifactMode.flag); mode != nil {
+			var err error
+			*artifactMode.value, err = cast.ToStringE(mode)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", artifactMode.flag, err)
+			}
+		}
+		if err := keeper.ValidateSnapshotArtifactMode(*artifactMode.value); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", artifactMode.flag, err)
+		}
+	}
+
+	if interval := appOpts.Get(FlagExportDataCheckInterval); interval != nil {
+		var err error
+		config.ExportDataCheckInterval, err = cast.ToInt64E(interval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", FlagExportDataCheckInterval, err)
+		}
+	}
+	if config.ExportDataCheckInterval < 0 {
+		return nil, fmt.Errorf("%s cannot be negative, got %d", FlagExportDataCheckInterval, config.ExportDataCheckInterval)
+	}
+
+	return &config, nil
+}
@@ -0,0 +1,115 @@
+package swingset
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// swingStoreExportDataHashActionType asks the VM for the hash of the export
+// data of its last committed block, in the format of SwingStoreExportDataHash.
+// Like SWING_STORE_EXPORT, the action is handled outside of the block
+// lifecycle, so it is safe to send from a goroutine.
+const swingStoreExportDataHashActionType = "SWING_STORE_EXPORT_DATA_HASH"
+
+type swingStoreExportDataHashAction struct {
+	Type        string `json:"type"` // "SWING_STORE_EXPORT_DATA_HASH"
+	BlockHeight int64  `json:"blockHeight"`
+}
+
+// swingStoreExportDataHashReply is the VM's reply to
+// SWING_STORE_EXPORT_DATA_HASH, or null if the VM cannot provide a hash.
+type swingStoreExportDataHashReply struct {
+	BlockHeight int64  `json:"blockHeight"`
+	Hash        string `json:"hash"`
+}
+
+// InitiateSwingStoreExportDataCheck starts comparing, in a goroutine, the hash
+// of the export data replicated in the swingset store with the hash of the
+// export data of the VM, as of the block just committed. The given context
+// must be a read-only view of the committed block, which the goroutine may use
+// while the following blocks execute. Nothing is started if a check isn't due
+// for this block or if the previous check is still running. A mismatch is
+// logged, reported by telemetry and recorded in the keeper.
+func InitiateSwingStoreExportDataCheck(ctx sdk.Context, keeper Keeper, blockingSend func(action vm.Jsonable) (string, error)) {
+	if !keeper.StartExportDataCheck(ctx.BlockHeight()) {
+		return
+	}
+	go func() {
+		defer keeper.FinishExportDataCheck()
+		if err := checkSwingStoreExportData(ctx, keeper, blockingSend); err != nil {
+			keeper.Logger(ctx).Error("failed to check swing-store export data", "error", err)
+		}
+	}()
+}
+
+func checkSwingStoreExportData(ctx sdk.Context, keeper Keeper, blockingSend func(action vm.Jsonable) (string, error)) error {
+	committedHeight := ctx.BlockHeight()
+
+	// Hash the replica while the VM hashes its export data, so that the VM
+	// reads it before committing another block, if possible.
+	type hashResult struct {
+		hash string
+		err  error
+	}
+	hashed := make(chan hashResult, 1)
+	go func() {
+		reader := agoric.NewKVIteratorReader(keeper.GetSwingStore(ctx).Iterator(nil, nil))
+		defer reader.Close()
+		hash, err := SwingStoreExportDataHash(reader)
+		hashed <- hashResult{hash, err}
+	}()
+
+	out, err := blockingSend(swingStoreExportDataHashAction{
+		Type:        swingStoreExportDataHashActionType,
+		BlockHeight: committedHeight,
+	})
+	result := <-hashed
+	if err != nil {
+		return err
+	}
+	if result.err != nil {
+		return result.err
+	}
+	hash := result.hash
+	if out == "" || out == "null" || out == "undefined" {
+		keeper.Logger(ctx).Info("VM did not provide a swing-store export data hash")
+		return nil
+	}
+	var reply swingStoreExportDataHashReply
+	if err := json.Unmarshal([]byte(out), &reply); err != nil {
+		return fmt.Errorf("cannot parse %s reply %q: %w", swingStoreExportDataHashActionType, out, err)
+	}
+
+	// The VM may have committed following blocks by the time it reads its
+	// export data, in which case the export data cannot be compared.
+	if reply.BlockHeight != committedHeight {
+		keeper.Logger(ctx).Info("skipping swing-store export data check",
+			"height", committedHeight, "vmHeight", reply.BlockHeight)
+		return nil
+	}
+
+	check := types.ExportDataCheck{
+		BlockHeight: committedHeight,
+		Hash:        hash,
+		VmHash:      reply.Hash,
+		Consistent:  hash == reply.Hash,
+	}
+	keeper.RecordExportDataCheck(check)
+
+	mismatch := float32(0)
+	if !check.Consistent {
+		mismatch = 1
+		telemetry.IncrCounter(1, types.ModuleName, "swing_store_export_data_mismatches")
+		keeper.Logger(ctx).Error("swing-store export data doesn't match the VM",
+			"height", committedHeight, "hash", hash, "vmHash", reply.Hash)
+	}
+	telemetry.SetGauge(mismatch, types.ModuleName, "swing_store_export_data_mismatch")
+	return nil
+}
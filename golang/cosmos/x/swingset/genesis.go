@@ -2,12 +2,12 @@ package swingset
 
 import (
 	// "os"
-	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"hash"
+	"io"
 	"strings"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
@@ -23,6 +23,16 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if err := data.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	indices := map[uint64]bool{}
+	for _, sender := range data.InboundQueueSenders {
+		if _, err := sdk.AccAddressFromBech32(sender.Sender); err != nil {
+			return fmt.Errorf("invalid inbound queue sender %s: %w", sender.Sender, err)
+		}
+		if indices[sender.Index] {
+			return fmt.Errorf("duplicate inbound queue sender index %d", sender.Index)
+		}
+		indices[sender.Index] = true
+	}
 	return nil
 }
 
@@ -31,6 +41,7 @@ func DefaultGenesisState() *types.GenesisState {
 		Params:               types.DefaultParams(),
 		State:                types.State{},
 		SwingStoreExportData: []*types.SwingStoreExportDataEntry{},
+		InboundQueueSenders:  []types.InboundQueueSender{},
 	}
 }
 
@@ -39,6 +50,9 @@ func DefaultGenesisState() *types.GenesisState {
 func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string, data *types.GenesisState) bool {
 	k.SetParams(ctx, data.GetParams())
 	k.SetState(ctx, data.GetState())
+	if err := k.ImportInboundQueueSenders(ctx, data.GetInboundQueueSenders()); err != nil {
+		panic(err)
+	}
 
 	swingStoreExportData := data.GetSwingStoreExportData()
 	if len(swingStoreExportData) == 0 && data.SwingStoreExportDataHash == "" {
@@ -78,6 +92,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 		if err != nil {
 			panic(err)
 		}
+		expectedHash := fmt.Sprintf("sha256:%x", sha256Hash)
 		getExportDataReader = func() (agoric.KVEntryReader, error) {
 			kvReader, err := artifactProvider.GetExportDataReader()
 			if err != nil {
@@ -88,11 +103,9 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 				return nil, fmt.Errorf("swing-store export has no export data")
 			}
 
-			hasher := sha256.New()
-			encoder := json.NewEncoder(hasher)
-			encoder.SetEscapeHTML(false)
+			hashingReader := NewSwingStoreExportDataHashingReader(kvReader)
 
-			return agoric.NewKVHookingReader(kvReader, func(entry agoric.KVEntry) error {
+			return agoric.NewKVHookingReader(hashingReader, func(entry agoric.KVEntry) error {
 				key := []byte(entry.Key())
 
 				if !entry.HasValue() {
@@ -101,11 +114,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 					swingStore.Set(key, []byte(entry.StringValue()))
 				}
 
-				return encoder.Encode(entry)
+				return nil
 			}, func() error {
-				sum := hasher.Sum(nil)
-				if !bytes.Equal(sum, sha256Hash) {
-					return fmt.Errorf("swing-store data sha256sum didn't match. expected %x, got %x", sha256Hash, sum)
+				hash := hashingReader.Hash()
+				if hash != expectedHash {
+					return fmt.Errorf("swing-store data hash didn't match. expected %s, got %s", expectedHash, hash)
 				}
 				return nil
 			}), nil
@@ -130,36 +143,52 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 	return false
 }
 
-func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string) *types.GenesisState {
+// GenesisSwingStoreExportOptions are the options of the swing-store export
+// written by ExportGenesis.
+type GenesisSwingStoreExportOptions struct {
+	// BaseExportDir is the swing-store directory of a previous genesis export.
+	// If set, the export is layered on top of it.
+	BaseExportDir string
+	// ExportDataFormat is the format of the "export data" file, like
+	// "binary+zstd". If empty, JSON lines are written.
+	ExportDataFormat string
+}
+
+// ExportGenesis exports the swingset genesis state, and writes the swing-store
+// export to swingStoreExportDir.
+func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string, swingStoreExportOptions GenesisSwingStoreExportOptions) *types.GenesisState {
 	gs := &types.GenesisState{
 		Params:               k.GetParams(ctx),
 		State:                k.GetState(ctx),
 		SwingStoreExportData: nil,
+		InboundQueueSenders:  k.ExportInboundQueueSenders(ctx),
 	}
 
 	snapshotHeight := uint64(ctx.BlockHeight())
 
-	eventHandler := swingStoreGenesisEventHandler{exportDir: swingStoreExportDir, snapshotHeight: snapshotHeight, swingStore: k.GetSwingStore(ctx), hasher: sha256.New()}
+	eventHandler := swingStoreGenesisEventHandler{exportDir: swingStoreExportDir, snapshotHeight: snapshotHeight, swingStore: k.GetSwingStore(ctx), exportDataHash: new(string)}
 
 	err := swingStoreExportsHandler.InitiateExport(
 		// The export will fail if the export of a historical height was requested
 		snapshotHeight,
 		eventHandler,
 		keeper.SwingStoreExportOptions{
-			ArtifactMode:   keeper.SwingStoreArtifactModeOperational,
-			ExportDataMode: keeper.SwingStoreExportDataModeSkip,
+			ArtifactMode:     keeper.SwingStoreArtifactModeOperational,
+			ExportDataMode:   keeper.SwingStoreExportDataModeSkip,
+			BaseExportDir:    swingStoreExportOptions.BaseExportDir,
+			ExportDataFormat: swingStoreExportOptions.ExportDataFormat,
 		},
 	)
 	if err != nil {
 		panic(err)
 	}
 
-	err = keeper.WaitUntilSwingStoreExportDone()
+	err = swingStoreExportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		panic(err)
 	}
 
-	gs.SwingStoreExportDataHash = fmt.Sprintf("sha256:%x", eventHandler.hasher.Sum(nil))
+	gs.SwingStoreExportDataHash = *eventHandler.exportDataHash
 
 	return gs
 }
@@ -168,7 +197,8 @@ type swingStoreGenesisEventHandler struct {
 	exportDir      string
 	snapshotHeight uint64
 	swingStore     sdk.KVStore
-	hasher         hash.Hash
+	// exportDataHash receives the hash of the "export data" once written.
+	exportDataHash *string
 }
 
 func (eventHandler swingStoreGenesisEventHandler) OnExportStarted(height uint64, retrieveSwingStoreExport func() error) error {
@@ -183,19 +213,67 @@ func (eventHandler swingStoreGenesisEventHandler) OnExportRetrieved(provider kee
 	artifactsProvider := keeper.SwingStoreExportProvider{
 		GetExportDataReader: func() (agoric.KVEntryReader, error) {
 			exportDataIterator := eventHandler.swingStore.Iterator(nil, nil)
-			kvReader := agoric.NewKVIteratorReader(exportDataIterator)
-			eventHandler.hasher.Reset()
-			encoder := json.NewEncoder(eventHandler.hasher)
-			encoder.SetEscapeHTML(false)
+			hashingReader := NewSwingStoreExportDataHashingReader(agoric.NewKVIteratorReader(exportDataIterator))
 
-			return agoric.NewKVHookingReader(kvReader, func(entry agoric.KVEntry) error {
-				return encoder.Encode(entry)
+			return agoric.NewKVHookingReader(hashingReader, func(entry agoric.KVEntry) error {
+				return nil
 			}, func() error {
+				*eventHandler.exportDataHash = hashingReader.Hash()
 				return nil
 			}), nil
 		},
 		ReadNextArtifact: provider.ReadNextArtifact,
+		BaseExportDir:    provider.BaseExportDir,
+		ExportDataFormat: provider.ExportDataFormat,
 	}
 
 	return keeper.WriteSwingStoreExportToDirectory(artifactsProvider, eventHandler.exportDir)
 }
+
+// SwingStoreExportDataHashingReader is a KVEntryReader which hashes the
+// "export data" entries read from its source reader.
+type SwingStoreExportDataHashingReader struct {
+	agoric.KVEntryReader
+	hasher  hash.Hash
+	encoder *json.Encoder
+}
+
+// NewSwingStoreExportDataHashingReader returns a reader of the "export data"
+// entries of the source reader, which hashes them as they are read.
+func NewSwingStoreExportDataHashingReader(reader agoric.KVEntryReader) *SwingStoreExportDataHashingReader {
+	hasher := sha256.New()
+	encoder := json.NewEncoder(hasher)
+	encoder.SetEscapeHTML(false)
+	return &SwingStoreExportDataHashingReader{KVEntryReader: reader, hasher: hasher, encoder: encoder}
+}
+
+// Read yields the next KVEntry from the source reader, hashing it.
+// Implements KVEntryReader
+func (hr *SwingStoreExportDataHashingReader) Read() (agoric.KVEntry, error) {
+	entry, err := hr.KVEntryReader.Read()
+	if err == nil {
+		err = hr.encoder.Encode(entry)
+	}
+	return entry, err
+}
+
+// Hash returns the hash of the entries read so far, in the format of the
+// genesis swing_store_export_data_hash.
+func (hr *SwingStoreExportDataHashingReader) Hash() string {
+	return fmt.Sprintf("sha256:%x", hr.hasher.Sum(nil))
+}
+
+// SwingStoreExportDataHash consumes the "export data" of a swing-store export
+// and returns its hash in the format of the genesis swing_store_export_data_hash.
+func SwingStoreExportDataHash(reader agoric.KVEntryReader) (string, error) {
+	hashingReader := NewSwingStoreExportDataHashingReader(reader)
+	for {
+		_, err := hashingReader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+	}
+	return hashingReader.Hash(), nil
+}
@@ -2,6 +2,10 @@ package swingset
 
 import (
 	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestDefaultGenesis(t *testing.T) {
@@ -10,3 +14,46 @@ func TestDefaultGenesis(t *testing.T) {
 		t.Errorf("DefaultGenesisState did not validate %v: %e", defaultGenesisState, err)
 	}
 }
+
+func TestValidateGenesisInboundQueueSenders(t *testing.T) {
+	sender := sdk.AccAddress([]byte("alice")).String()
+	genesisState := DefaultGenesisState()
+	genesisState.InboundQueueSenders = []types.InboundQueueSender{
+		{Index: 0, Sender: sender, MsgType: "/test.MsgA"},
+		{Index: 1, Sender: sender, MsgType: "/test.MsgA"},
+	}
+	if err := ValidateGenesis(genesisState); err != nil {
+		t.Errorf("got error %v, want none", err)
+	}
+	genesisState.InboundQueueSenders[1].Index = 0
+	if err := ValidateGenesis(genesisState); err == nil {
+		t.Errorf("wanted error for duplicate index")
+	}
+	genesisState.InboundQueueSenders = []types.InboundQueueSender{{Sender: "bogus"}}
+	if err := ValidateGenesis(genesisState); err == nil {
+		t.Errorf("wanted error for invalid sender")
+	}
+}
+
+// The same entries and hash are checked by the VM's hashExportDataEntries in
+// packages/cosmic-swingset/test/export-data-hash.test.js.
+func TestSwingStoreExportDataHash(t *testing.T) {
+	k, ctx := makeTestKeeper(t)
+	store := k.GetSwingStore(ctx)
+	// Set out of order, including a character outside of the BMP which sorts
+	// after U+FF61 in UTF-8 but before it in UTF-16.
+	store.Set([]byte("\U0001F600"), []byte("astral"))
+	store.Set([]byte("\uFF61"), []byte("halfwidth"))
+	store.Set([]byte("a<b>&c"), []byte("line\u2028paragraph\u2029"))
+	store.Set([]byte("a"), []byte(`{"quote":"\"","backslash":"\\"}`))
+
+	reader := agoric.NewKVIteratorReader(store.Iterator(nil, nil))
+	defer reader.Close()
+	hash, err := SwingStoreExportDataHash(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != "sha256:751f1e93200eccb9e135779e789dfb6bfc2b1f78bb47c045c32173fc7d3e8b06" {
+		t.Errorf("got hash %s", hash)
+	}
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// BlockStatsRetentionBlocks is the number of most recent blocks whose
+// execution statistics are kept in state.
+const BlockStatsRetentionBlocks = 10_000
+
+func (k Keeper) getBlockStatsStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(blockStatsKeyPrefix))
+}
+
+// parseEndBlockReply returns the block stats of the VM's reply to END_BLOCK,
+// and whether the VM reported any.
+func parseEndBlockReply(ctx sdk.Context, out string) (types.BlockStats, bool, error) {
+	if out == "" || out == "null" || out == "undefined" {
+		return types.BlockStats{}, false, nil
+	}
+	stats := types.BlockStats{
+		Computrons: sdkmath.ZeroUint(),
+		BeansUsed:  sdkmath.ZeroUint(),
+	}
+	if err := json.Unmarshal([]byte(out), &stats); err != nil {
+		return types.BlockStats{}, false, fmt.Errorf("cannot parse END_BLOCK reply %q: %w", out, err)
+	}
+	stats.BlockHeight = ctx.BlockHeight()
+	return stats, true, nil
+}
+
+// RecordEndBlockReply records the block stats of the VM's reply to END_BLOCK,
+// returning them and whether the VM reported any.
+func (k Keeper) RecordEndBlockReply(ctx sdk.Context, out string) (types.BlockStats, bool, error) {
+	stats, found, err := parseEndBlockReply(ctx, out)
+	if err != nil || !found {
+		return stats, found, err
+	}
+	k.RecordBlockStats(ctx, stats)
+	return stats, true, nil
+}
+
+// RecordBlockStats stores the execution statistics of the current block and
+// discards those older than BlockStatsRetentionBlocks.
+func (k Keeper) RecordBlockStats(ctx sdk.Context, stats types.BlockStats) {
+	store := k.getBlockStatsStore(ctx)
+	store.Set(sdk.Uint64ToBigEndian(uint64(stats.BlockHeight)), k.cdc.MustMarshal(&stats))
+
+	oldest := stats.BlockHeight - BlockStatsRetentionBlocks
+	if oldest <= 0 {
+		return
+	}
+	// Entries are ordered by height, so stop at the oldest retained height.
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(oldest)))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetBlockStats returns the recorded execution statistics of the blocks
+// between minHeight and maxHeight inclusive, in increasing order of height.
+// A zero bound is unlimited.
+func (k Keeper) GetBlockStats(ctx sdk.Context, minHeight, maxHeight int64) []types.BlockStats {
+	var start, end []byte
+	if minHeight > 0 {
+		start = sdk.Uint64ToBigEndian(uint64(minHeight))
+	}
+	if maxHeight > 0 {
+		end = sdk.Uint64ToBigEndian(uint64(maxHeight) + 1)
+	}
+	iterator := k.getBlockStatsStore(ctx).Iterator(start, end)
+	defer iterator.Close()
+
+	statsList := []types.BlockStats{}
+	for ; iterator.Valid(); iterator.Next() {
+		stats := types.BlockStats{}
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		statsList = append(statsList, stats)
+	}
+	return statsList
+}
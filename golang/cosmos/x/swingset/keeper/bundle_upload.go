@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdkioerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// BundleUploadExpiryBlocks is the number of blocks after which a pending
+// bundle upload is discarded if it has not been finished.
+const BundleUploadExpiryBlocks = 1_000
+
+// bundleUploadKey identifies a pending upload by its submitter and its
+// fixed-length bundle hash.
+func bundleUploadKey(submitter sdk.AccAddress, bundleHash string) []byte {
+	return append(address.MustLengthPrefix(submitter), []byte(bundleHash)...)
+}
+
+func (k Keeper) getBundleUploadStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(bundleUploadKeyPrefix))
+}
+
+func (k Keeper) getBundleUploadChunkStore(ctx sdk.Context, uploadKey []byte) sdk.KVStore {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(bundleUploadChunkKeyPrefix))
+	return prefix.NewStore(store, uploadKey)
+}
+
+func (k Keeper) getBundleUploadExpiryStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(bundleUploadExpiryKeyPrefix))
+}
+
+func bundleUploadExpiryKey(expiryHeight int64, uploadKey []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expiryHeight)), uploadKey...)
+}
+
+// GetPendingBundleUpload returns the pending upload of a bundle by a
+// submitter, and whether there is one that has not expired.
+func (k Keeper) GetPendingBundleUpload(ctx sdk.Context, submitter sdk.AccAddress, bundleHash string) (types.PendingBundleUpload, bool) {
+	bz := k.getBundleUploadStore(ctx).Get(bundleUploadKey(submitter, bundleHash))
+	if bz == nil {
+		return types.PendingBundleUpload{}, false
+	}
+	upload := types.PendingBundleUpload{}
+	k.cdc.MustUnmarshal(bz, &upload)
+	if ctx.BlockHeight() > upload.ExpiryHeight {
+		return types.PendingBundleUpload{}, false
+	}
+	return upload, true
+}
+
+func (k Keeper) setPendingBundleUpload(ctx sdk.Context, upload types.PendingBundleUpload) {
+	bz := k.cdc.MustMarshal(&upload)
+	k.getBundleUploadStore(ctx).Set(bundleUploadKey(upload.Submitter, upload.BundleHash), bz)
+}
+
+// deleteBundleUpload removes all the state of an upload, whether or not it has
+// expired.
+func (k Keeper) deleteBundleUpload(ctx sdk.Context, uploadKey []byte) {
+	uploadStore := k.getBundleUploadStore(ctx)
+	bz := uploadStore.Get(uploadKey)
+	if bz == nil {
+		return
+	}
+	upload := types.PendingBundleUpload{}
+	k.cdc.MustUnmarshal(bz, &upload)
+	uploadStore.Delete(uploadKey)
+	k.getBundleUploadExpiryStore(ctx).Delete(bundleUploadExpiryKey(upload.ExpiryHeight, uploadKey))
+
+	chunkStore := k.getBundleUploadChunkStore(ctx, uploadKey)
+	for i := uint32(0); i < upload.ChunkCount; i++ {
+		chunkStore.Delete(sdk.Uint64ToBigEndian(uint64(i)))
+	}
+}
+
+// BeginBundleUpload records a pending bundle upload, replacing any previous
+// upload of the same bundle by the same submitter.
+func (k Keeper) BeginBundleUpload(ctx sdk.Context, msg *types.MsgBeginBundleUpload) error {
+	uploadKey := bundleUploadKey(msg.Submitter, msg.BundleHash)
+	k.deleteBundleUpload(ctx, uploadKey)
+
+	upload := types.PendingBundleUpload{
+		Submitter:        msg.Submitter,
+		BundleHash:       msg.BundleHash,
+		CompressedSize:   msg.CompressedSize,
+		UncompressedSize: msg.UncompressedSize,
+		ExpiryHeight:     ctx.BlockHeight() + BundleUploadExpiryBlocks,
+	}
+	k.setPendingBundleUpload(ctx, upload)
+	k.getBundleUploadExpiryStore(ctx).Set(bundleUploadExpiryKey(upload.ExpiryHeight, uploadKey), []byte{})
+	return nil
+}
+
+// UploadBundleChunk stores the next chunk of a pending bundle upload.
+func (k Keeper) UploadBundleChunk(ctx sdk.Context, msg *types.MsgUploadBundleChunk) error {
+	upload, found := k.GetPendingBundleUpload(ctx, msg.Submitter, msg.BundleHash)
+	if !found {
+		return sdkioerrors.Wrapf(sdkerrors.ErrNotFound, "no pending upload of bundle %s", msg.BundleHash)
+	}
+	if msg.ChunkIndex != upload.ChunkCount {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected chunk %d, got %d", upload.ChunkCount, msg.ChunkIndex)
+	}
+	if upload.ReceivedSize+int64(len(msg.Chunk)) > upload.CompressedSize {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chunk %d exceeds the declared compressed size %d", msg.ChunkIndex, upload.CompressedSize)
+	}
+
+	uploadKey := bundleUploadKey(upload.Submitter, upload.BundleHash)
+	k.getBundleUploadChunkStore(ctx, uploadKey).Set(sdk.Uint64ToBigEndian(uint64(msg.ChunkIndex)), msg.Chunk)
+	upload.ChunkCount++
+	upload.ReceivedSize += int64(len(msg.Chunk))
+	k.setPendingBundleUpload(ctx, upload)
+	return nil
+}
+
+// FinishBundleUpload assembles and removes a completely uploaded bundle,
+// returning it as an uncompressed MsgInstallBundle once its hash is verified.
+func (k Keeper) FinishBundleUpload(ctx sdk.Context, msg *types.MsgFinishBundleUpload) (*types.MsgInstallBundle, error) {
+	upload, found := k.GetPendingBundleUpload(ctx, msg.Submitter, msg.BundleHash)
+	if !found {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrNotFound, "no pending upload of bundle %s", msg.BundleHash)
+	}
+	if upload.ReceivedSize != upload.CompressedSize {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "received %d of %d bytes", upload.ReceivedSize, upload.CompressedSize)
+	}
+
+	uploadKey := bundleUploadKey(upload.Submitter, upload.BundleHash)
+	chunkStore := k.getBundleUploadChunkStore(ctx, uploadKey)
+	var compressed bytes.Buffer
+	compressed.Grow(int(upload.CompressedSize))
+	for i := uint32(0); i < upload.ChunkCount; i++ {
+		compressed.Write(chunkStore.Get(sdk.Uint64ToBigEndian(uint64(i))))
+	}
+	k.deleteBundleUpload(ctx, uploadKey)
+
+	installMsg := &types.MsgInstallBundle{
+		Submitter:        upload.Submitter,
+		CompressedBundle: compressed.Bytes(),
+		UncompressedSize: upload.UncompressedSize,
+	}
+	if err := installMsg.Uncompress(); err != nil {
+		return nil, err
+	}
+	if hash := types.BundleHashOf(installMsg.Bundle); hash != upload.BundleHash {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bundle hash %s does not match declared hash %s", hash, upload.BundleHash)
+	}
+	return installMsg, nil
+}
+
+// PruneExpiredBundleUploads discards the state of bundle uploads which have
+// expired.
+func (k Keeper) PruneExpiredBundleUploads(ctx sdk.Context) {
+	expiryStore := k.getBundleUploadExpiryStore(ctx)
+	// Entries are ordered by expiry height, so stop at the current height.
+	iterator := expiryStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	var uploadKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		uploadKeys = append(uploadKeys, iterator.Key()[8:])
+	}
+	iterator.Close()
+
+	for _, uploadKey := range uploadKeys {
+		k.deleteBundleUpload(ctx, uploadKey)
+	}
+	if len(uploadKeys) > 0 {
+		k.Logger(ctx).Debug(fmt.Sprintf("pruned %d expired bundle uploads", len(uploadKeys)))
+	}
+}
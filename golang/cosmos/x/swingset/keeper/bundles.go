@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+func (k Keeper) getInstalledBundleStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(installedBundleKeyPrefix))
+}
+
+// GetInstalledBundle returns the installation record of a bundle, and whether
+// there is one.
+func (k Keeper) GetInstalledBundle(ctx sdk.Context, bundleID string) (types.InstalledBundle, bool) {
+	bz := k.getInstalledBundleStore(ctx).Get([]byte(bundleID))
+	if bz == nil {
+		return types.InstalledBundle{}, false
+	}
+	bundle := types.InstalledBundle{}
+	k.cdc.MustUnmarshal(bz, &bundle)
+	return bundle, true
+}
+
+// SetInstalledBundle sets the installation record of a bundle.
+func (k Keeper) SetInstalledBundle(ctx sdk.Context, bundle types.InstalledBundle) {
+	bz := k.cdc.MustMarshal(&bundle)
+	k.getInstalledBundleStore(ctx).Set([]byte(bundle.BundleId), bz)
+}
+
+// IsBundleAccepted returns whether the VM has accepted the installation of a
+// bundle.
+func (k Keeper) IsBundleAccepted(ctx sdk.Context, bundleID string) bool {
+	bundle, found := k.GetInstalledBundle(ctx, bundleID)
+	return found && bundle.Accepted
+}
+
+// RecordBundleInstall records the pending installation of a bundle, unless
+// the bundle has no ID because it is not valid JSON, in which case the VM will
+// reject it anyway. Only a rejected installation is replaced: the VM won't
+// forget about an accepted bundle, and a pending one keeps the submitter and
+// height of its first installation.
+func (k Keeper) RecordBundleInstall(ctx sdk.Context, msg *types.MsgInstallBundle) {
+	bundleID, err := msg.BundleID()
+	if err != nil {
+		return
+	}
+	if bundle, found := k.GetInstalledBundle(ctx, bundleID); found && (bundle.Pending || bundle.Accepted) {
+		return
+	}
+	k.SetInstalledBundle(ctx, types.InstalledBundle{
+		BundleId:    bundleID,
+		Submitter:   msg.Submitter,
+		BlockHeight: ctx.BlockHeight(),
+		Pending:     true,
+	})
+}
+
+// RecordBundleInstallOutcome updates the installation record of a bundle with
+// the outcome reported by the VM. An accepted bundle stays accepted, since the
+// ID claimed by a bundle is not verified until the VM installs it: another
+// bundle claiming the same ID is rejected without affecting the accepted one.
+func (k Keeper) RecordBundleInstallOutcome(ctx sdk.Context, bundleID string, accepted bool, errMsg string) error {
+	bundle, found := k.GetInstalledBundle(ctx, bundleID)
+	if !found {
+		return fmt.Errorf("no installation of bundle %s", bundleID)
+	}
+	if bundle.Accepted {
+		return nil
+	}
+	bundle.Pending = false
+	bundle.Accepted = accepted
+	bundle.Error = errMsg
+	k.SetInstalledBundle(ctx, bundle)
+	return nil
+}
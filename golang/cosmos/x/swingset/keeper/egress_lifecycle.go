@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// checkEgressSubmitter returns an error unless the submitter may manage the
+// egress of the address, which is the case for the governance authority and
+// for the address itself. It also returns whether the submitter is exempt from
+// power flag fees: the governance authority is, and so is an address holding a
+// provisionpass, but only for its own egress.
+func (k Keeper) checkEgressSubmitter(ctx sdk.Context, submitter, addr sdk.AccAddress) (bool, error) {
+	if submitter.String() == k.authority {
+		return true, nil
+	}
+	if !submitter.Equals(addr) {
+		return false, sdkioerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s may not manage the egress of %s", submitter, addr)
+	}
+	return k.bankKeeper.GetAllBalances(ctx, submitter).IsAllGTE(privilegedProvisioningCoins), nil
+}
+
+// getProvisionedEgress returns the egress of an address, or an error if the
+// address is not provisioned.
+func (k Keeper) getProvisionedEgress(ctx sdk.Context, addr sdk.AccAddress) (types.Egress, error) {
+	egress := k.GetEgress(ctx, addr)
+	if egress.Peer.Empty() {
+		return egress, sdkioerrors.Wrapf(sdkerrors.ErrNotFound, "%s is not provisioned", addr)
+	}
+	return egress, nil
+}
+
+// UpdateEgressPowerFlags adds and removes power flags of the egress of a
+// provisioned address, returning the resulting power flags.  Only the power
+// flags of the PowerFlagFees may be added, and unless the submitter is exempt,
+// it is charged the fees of those which the egress didn't already have.
+func (k Keeper) UpdateEgressPowerFlags(ctx sdk.Context, submitter, addr sdk.AccAddress, addPowerFlags, removePowerFlags []string) ([]string, error) {
+	egress, err := k.getProvisionedEgress(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	feeExempt, err := k.checkEgressSubmitter(ctx, submitter, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	has := make(map[string]bool, len(egress.PowerFlags))
+	for _, powerFlag := range egress.PowerFlags {
+		has[powerFlag] = true
+	}
+	var added []string
+	for _, powerFlag := range addPowerFlags {
+		if !has[powerFlag] {
+			has[powerFlag] = true
+			added = append(added, powerFlag)
+		}
+	}
+
+	fees, err := powerFlagsFee(added, k.GetParams(ctx).PowerFlagFees)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if !feeExempt && !fees.IsZero() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitter, k.feeCollectorName, fees)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	removed := make(map[string]bool, len(removePowerFlags))
+	for _, powerFlag := range removePowerFlags {
+		removed[powerFlag] = true
+	}
+	powerFlags := []string{}
+	for _, powerFlag := range append(egress.PowerFlags, added...) {
+		if !removed[powerFlag] {
+			powerFlags = append(powerFlags, powerFlag)
+		}
+	}
+
+	egress.PowerFlags = powerFlags
+	if err := k.SetEgress(ctx, &egress); err != nil {
+		return nil, err
+	}
+	return powerFlags, nil
+}
+
+// Deprovision removes the egress of a provisioned address.  The account
+// itself is left alone.
+func (k Keeper) Deprovision(ctx sdk.Context, submitter, addr sdk.AccAddress) error {
+	if _, err := k.getProvisionedEgress(ctx, addr); err != nil {
+		return err
+	}
+	if _, err := k.checkEgressSubmitter(ctx, submitter, addr); err != nil {
+		return err
+	}
+
+	path := StoragePathEgress + "." + addr.String()
+	// FIXME: We should use just SetStorageAndNotify here, but solo needs legacy for now.
+	k.vstorageKeeper.LegacySetStorageAndNotify(ctx, agoric.NewKVEntryWithNoValue(path))
+	return nil
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"sync"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// The swing-store "export data" is replicated into the swingStore. prefix of
+// the swingset store by the VM's swingStoreUpdateExportData calls. A node can
+// periodically compare a hash of this replica with a hash of the export data
+// computed by the VM. Since the interval is a node configuration, the checks
+// run after commit outside of consensus, and their outcome is kept in memory
+// rather than in consensus state.
+
+// exportDataCheckState is the configuration and outcome of the export data
+// checks, shared by all copies of a Keeper.
+type exportDataCheckState struct {
+	mu         sync.Mutex
+	interval   int64
+	running    bool
+	latest     *types.ExportDataCheck
+	mismatches uint64
+}
+
+// SetExportDataCheckInterval sets the interval in blocks between checks of
+// the export data, or disables them if 0.
+func (k Keeper) SetExportDataCheckInterval(interval int64) {
+	k.exportDataCheck.mu.Lock()
+	defer k.exportDataCheck.mu.Unlock()
+	k.exportDataCheck.interval = interval
+}
+
+// GetExportDataCheckInterval returns the interval in blocks between checks of
+// the export data, or 0 if they are disabled.
+func (k Keeper) GetExportDataCheckInterval() int64 {
+	k.exportDataCheck.mu.Lock()
+	defer k.exportDataCheck.mu.Unlock()
+	return k.exportDataCheck.interval
+}
+
+// StartExportDataCheck returns whether the export data of the block just
+// committed at the given height should be checked, in which case the check is
+// considered running until FinishExportDataCheck. Checks don't overlap, so a
+// check which is due while the previous one is running is skipped.
+func (k Keeper) StartExportDataCheck(height int64) bool {
+	k.exportDataCheck.mu.Lock()
+	defer k.exportDataCheck.mu.Unlock()
+	interval := k.exportDataCheck.interval
+	if interval <= 0 || height%interval != 0 || k.exportDataCheck.running {
+		return false
+	}
+	k.exportDataCheck.running = true
+	return true
+}
+
+// FinishExportDataCheck marks the end of a check started by
+// StartExportDataCheck.
+func (k Keeper) FinishExportDataCheck() {
+	k.exportDataCheck.mu.Lock()
+	defer k.exportDataCheck.mu.Unlock()
+	k.exportDataCheck.running = false
+}
+
+// RecordExportDataCheck records the outcome of a check of the export data.
+func (k Keeper) RecordExportDataCheck(check types.ExportDataCheck) {
+	k.exportDataCheck.mu.Lock()
+	defer k.exportDataCheck.mu.Unlock()
+	k.exportDataCheck.latest = &check
+	if !check.Consistent {
+		k.exportDataCheck.mismatches++
+	}
+}
+
+// GetExportDataCheck returns the latest check of the export data, or nil if
+// none was performed, along with the number of mismatches found and the
+// configured interval.
+func (k Keeper) GetExportDataCheck() (*types.ExportDataCheck, uint64, int64) {
+	k.exportDataCheck.mu.Lock()
+	defer k.exportDataCheck.mu.Unlock()
+	var latest *types.ExportDataCheck
+	if k.exportDataCheck.latest != nil {
+		check := *k.exportDataCheck.latest
+		latest = &check
+	}
+	return latest, k.exportDataCheck.mismatches, k.exportDataCheck.interval
+}
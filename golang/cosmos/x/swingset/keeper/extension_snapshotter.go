@@ -11,6 +11,7 @@ import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	snapshots "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/klauspost/compress/zstd"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -22,8 +23,60 @@ import (
 var _ snapshots.ExtensionSnapshotter = &ExtensionSnapshotter{}
 var _ SwingStoreExportEventHandler = &ExtensionSnapshotter{}
 
-// SnapshotFormat 1 defines all extension payloads to be SwingStoreArtifact proto messages
-const SnapshotFormat = 1
+const (
+	// SnapshotFormatUncompressed defines all extension payloads to be
+	// SwingStoreArtifact proto messages
+	SnapshotFormatUncompressed = 1
+	// SnapshotFormatZstd defines all extension payloads to be zstd compressed
+	// SwingStoreArtifact proto messages
+	SnapshotFormatZstd = 2
+	// SnapshotFormatZstdReplay is SnapshotFormatZstd for the replay artifact
+	// set of the swing-store instead of the operational one
+	SnapshotFormatZstdReplay = 3
+	// SnapshotFormatZstdArchival is SnapshotFormatZstd for the archival
+	// artifact set of the swing-store instead of the operational one
+	SnapshotFormatZstdArchival = 4
+)
+
+// SnapshotFormat is the format used to create snapshots of the operational
+// artifact set
+const SnapshotFormat = SnapshotFormatZstd
+
+// snapshotFormatArtifactModes is the artifact mode of the swing-store export
+// included in each snapshot format.
+var snapshotFormatArtifactModes = map[uint32]string{
+	SnapshotFormatUncompressed: SwingStoreArtifactModeOperational,
+	SnapshotFormatZstd:         SwingStoreArtifactModeOperational,
+	SnapshotFormatZstdReplay:   SwingStoreArtifactModeReplay,
+	SnapshotFormatZstdArchival: SwingStoreArtifactModeArchival,
+}
+
+// snapshotArtifactModeRanks orders the artifact modes that snapshots can be
+// created with, each artifact set including those of lower rank.
+var snapshotArtifactModeRanks = map[string]int{
+	SwingStoreArtifactModeOperational: 1,
+	SwingStoreArtifactModeReplay:      2,
+	SwingStoreArtifactModeArchival:    3,
+}
+
+// ValidateSnapshotArtifactMode returns an error if state-sync snapshots
+// cannot be created with or restored at the given artifact mode.
+func ValidateSnapshotArtifactMode(artifactMode string) error {
+	if _, ok := snapshotArtifactModeRanks[artifactMode]; !ok {
+		return fmt.Errorf("invalid snapshot artifact mode %q, must be %q, %q or %q", artifactMode,
+			SwingStoreArtifactModeOperational, SwingStoreArtifactModeReplay, SwingStoreArtifactModeArchival)
+	}
+	return nil
+}
+
+// DefaultSnapshotCompressionLevel is the zstd compression level of the
+// extension payloads if not configured.
+const DefaultSnapshotCompressionLevel = 3
+
+// MaxSnapshotPayloadSize bounds the decompressed size of an extension payload
+// restored from a state-sync peer, like the cosmos-sdk bounds the size of the
+// snapshot items, and therefore of the uncompressed payloads.
+const MaxSnapshotPayloadSize uint64 = 512_000_000
 
 // snapshotDetails describes an in-progress state-sync snapshot
 type snapshotDetails struct {
@@ -59,8 +112,9 @@ type snapshotDetails struct {
 // snapshot operation is currently mediated by the SwingStoreExportsHandler,
 // which helps with the synchronization needed to generate consistent exports,
 // while allowing SwingSet activity to proceed for the next block. This relies
-// on the application calling WaitUntilSwingStoreExportStarted before
-// instructing SwingSet to commit a new block.
+// on the application calling the WaitUntilSwingStoreExportStarted method of
+// the SwingStoreExportsHandler before instructing SwingSet to commit a new
+// block.
 type ExtensionSnapshotter struct {
 	isConfigured func() bool
 	// takeAppSnapshot is called by OnExportStarted when creating a snapshot
@@ -69,6 +123,22 @@ type ExtensionSnapshotter struct {
 	getSwingStoreExportDataShadowCopyReader func(height int64) agoric.KVEntryReader
 	logger                                  log.Logger
 	activeSnapshot                          *snapshotDetails
+	// compressionLevel is the zstd compression level of the payloads, or 0 for
+	// DefaultSnapshotCompressionLevel.
+	compressionLevel int
+	// maxPayloadSize is the decompressed size above which a restored payload
+	// is rejected, or 0 for MaxSnapshotPayloadSize.
+	maxPayloadSize uint64
+	// restoreDir is the directory in which a restored export is written, so
+	// that an interrupted restore can resume. If empty, restores don't resume.
+	restoreDir string
+	// artifactMode is the artifact mode of the swing-store exports included in
+	// snapshots, or "" for SwingStoreArtifactModeOperational.
+	artifactMode string
+	// restoreArtifactMode is the artifact mode of the swing-store imports from
+	// restored snapshots, or "" for SwingStoreArtifactModeOperational.
+	// Snapshots of a lower artifact mode are rejected.
+	restoreArtifactMode string
 }
 
 // NewExtensionSnapshotter creates a new swingset ExtensionSnapshotter
@@ -76,6 +146,10 @@ func NewExtensionSnapshotter(
 	app *baseapp.BaseApp,
 	swingStoreExportsHandler *SwingStoreExportsHandler,
 	getSwingStoreExportDataShadowCopyReader func(height int64) agoric.KVEntryReader,
+	compressionLevel int,
+	restoreDir string,
+	artifactMode string,
+	restoreArtifactMode string,
 ) *ExtensionSnapshotter {
 	return &ExtensionSnapshotter{
 		isConfigured:                            func() bool { return app.SnapshotManager() != nil },
@@ -84,7 +158,38 @@ func NewExtensionSnapshotter(
 		swingStoreExportsHandler:                swingStoreExportsHandler,
 		getSwingStoreExportDataShadowCopyReader: getSwingStoreExportDataShadowCopyReader,
 		activeSnapshot:                          nil,
+		compressionLevel:                        compressionLevel,
+		restoreDir:                              restoreDir,
+		artifactMode:                            artifactMode,
+		restoreArtifactMode:                     restoreArtifactMode,
+	}
+}
+
+// getArtifactMode returns the artifact mode of the swing-store exports
+// included in snapshots.
+func (snapshotter *ExtensionSnapshotter) getArtifactMode() string {
+	if snapshotter.artifactMode == "" {
+		return SwingStoreArtifactModeOperational
 	}
+	return snapshotter.artifactMode
+}
+
+// getMaxPayloadSize returns the decompressed size above which a restored
+// payload is rejected.
+func (snapshotter *ExtensionSnapshotter) getMaxPayloadSize() uint64 {
+	if snapshotter.maxPayloadSize == 0 {
+		return MaxSnapshotPayloadSize
+	}
+	return snapshotter.maxPayloadSize
+}
+
+// getRestoreArtifactMode returns the artifact mode of the swing-store imports
+// from restored snapshots.
+func (snapshotter *ExtensionSnapshotter) getRestoreArtifactMode() string {
+	if snapshotter.restoreArtifactMode == "" {
+		return SwingStoreArtifactModeOperational
+	}
+	return snapshotter.restoreArtifactMode
 }
 
 // SnapshotName returns the name of the snapshotter, it should be unique in the manager.
@@ -95,17 +200,36 @@ func (snapshotter *ExtensionSnapshotter) SnapshotName() string {
 
 // SnapshotFormat returns the extension specific format used to encode the
 // extension payloads when creating a snapshot. It's independent of the format
-// used for the overall state-sync snapshot.
+// used for the overall state-sync snapshot, and records the artifact mode of
+// the included swing-store export.
 // Implements ExtensionSnapshotter
 func (snapshotter *ExtensionSnapshotter) SnapshotFormat() uint32 {
-	return SnapshotFormat
+	switch snapshotter.getArtifactMode() {
+	case SwingStoreArtifactModeReplay:
+		return SnapshotFormatZstdReplay
+	case SwingStoreArtifactModeArchival:
+		return SnapshotFormatZstdArchival
+	default:
+		return SnapshotFormat
+	}
 }
 
 // SupportedFormats returns a list of extension specific payload formats it can
-// restore from.
+// restore from, which are those including the artifacts required by the
+// restore artifact mode, so that the snapshot manager rejects the other formats
+// before restoring the extension. The format of the snapshots created by this
+// node is always included, as required by the snapshot manager.
 // Implements ExtensionSnapshotter
 func (snapshotter *ExtensionSnapshotter) SupportedFormats() []uint32 {
-	return []uint32{SnapshotFormat}
+	restoreRank := snapshotArtifactModeRanks[snapshotter.getRestoreArtifactMode()]
+	ownFormat := snapshotter.SnapshotFormat()
+	formats := []uint32{}
+	for _, format := range []uint32{SnapshotFormatZstdArchival, SnapshotFormatZstdReplay, SnapshotFormatZstd, SnapshotFormatUncompressed} {
+		if format == ownFormat || snapshotArtifactModeRanks[snapshotFormatArtifactModes[format]] >= restoreRank {
+			formats = append(formats, format)
+		}
+	}
+	return formats
 }
 
 // InitiateSnapshot initiates a snapshot for the given block height.
@@ -113,7 +237,8 @@ func (snapshotter *ExtensionSnapshotter) SupportedFormats() []uint32 {
 // configured, this will fail.
 //
 // The snapshot operation is performed in a goroutine.
-// Use WaitUntilSwingStoreExportStarted to synchronize commit boundaries.
+// Use the WaitUntilSwingStoreExportStarted method of the
+// SwingStoreExportsHandler to synchronize commit boundaries.
 func (snapshotter *ExtensionSnapshotter) InitiateSnapshot(height int64) error {
 	if !snapshotter.isConfigured() {
 		return fmt.Errorf("snapshot manager not configured")
@@ -125,7 +250,7 @@ func (snapshotter *ExtensionSnapshotter) InitiateSnapshot(height int64) error {
 	blockHeight := uint64(height)
 
 	return snapshotter.swingStoreExportsHandler.InitiateExport(blockHeight, snapshotter, SwingStoreExportOptions{
-		ArtifactMode:   SwingStoreArtifactModeOperational,
+		ArtifactMode:   snapshotter.getArtifactMode(),
 		ExportDataMode: SwingStoreExportDataModeSkip,
 	})
 }
@@ -213,11 +338,22 @@ func (snapshotter *ExtensionSnapshotter) OnExportRetrieved(provider SwingStoreEx
 		return fmt.Errorf("SwingStore export received for unexpected block height %d (app snapshot height is %d)", provider.BlockHeight, snapshotDetails.blockHeight)
 	}
 
+	compressionLevel := snapshotter.compressionLevel
+	if compressionLevel == 0 {
+		compressionLevel = DefaultSnapshotCompressionLevel
+	}
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(compressionLevel)))
+	if err != nil {
+		return err
+	}
+	defer encoder.Close()
+
 	writeArtifactToPayload := func(artifact types.SwingStoreArtifact) error {
-		payloadBytes, err := artifact.Marshal()
+		artifactBytes, err := artifact.Marshal()
 		if err != nil {
 			return err
 		}
+		payloadBytes := encoder.EncodeAll(artifactBytes, nil)
 
 		err = snapshotDetails.payloadWriter(payloadBytes)
 		if err != nil {
@@ -273,11 +409,35 @@ func (snapshotter *ExtensionSnapshotter) OnExportRetrieved(provider SwingStoreEx
 // RestoreExtension restores an extension state snapshot,
 // the payload reader returns io.EOF when it reaches the extension boundaries.
 // Implements ExtensionSnapshotter
+// Payloads of the older SnapshotFormatUncompressed are restored as is.
+// Snapshots with fewer artifacts than the restore artifact mode are rejected,
+// while those with more are restored at the restore artifact mode.
 func (snapshotter *ExtensionSnapshotter) RestoreExtension(blockHeight uint64, format uint32, payloadReader snapshots.ExtensionPayloadReader) error {
-	if format != SnapshotFormat {
+	var decoder *zstd.Decoder
+	switch format {
+	case SnapshotFormatUncompressed:
+	case SnapshotFormatZstd, SnapshotFormatZstdReplay, SnapshotFormatZstdArchival:
+		// The payloads come from untrusted peers and are only verified once
+		// decompressed, so bound the memory decompressing them may use.
+		var err error
+		decoder, err = zstd.NewReader(nil,
+			zstd.WithDecoderMaxMemory(snapshotter.getMaxPayloadSize()),
+			zstd.WithDecoderConcurrency(1),
+		)
+		if err != nil {
+			return err
+		}
+		defer decoder.Close()
+	default:
 		return snapshots.ErrUnknownFormat
 	}
 
+	artifactMode := snapshotFormatArtifactModes[format]
+	restoreArtifactMode := snapshotter.getRestoreArtifactMode()
+	if snapshotArtifactModeRanks[artifactMode] < snapshotArtifactModeRanks[restoreArtifactMode] {
+		return fmt.Errorf("swingset snapshot at height %d has %s artifacts, but %s artifacts are required", blockHeight, artifactMode, restoreArtifactMode)
+	}
+
 	if blockHeight > math.MaxInt64 {
 		return fmt.Errorf("snapshot block height %d is higher than max int64", blockHeight)
 	}
@@ -298,12 +458,23 @@ func (snapshotter *ExtensionSnapshotter) RestoreExtension(blockHeight uint64, fo
 			return artifact, err
 		}
 
+		if decoder != nil {
+			payloadBytes, err = decoder.DecodeAll(payloadBytes, nil)
+			if err != nil {
+				return artifact, fmt.Errorf("cannot decompress swingset snapshot payload: %w", err)
+			}
+		}
+
 		err = artifact.Unmarshal(payloadBytes)
 		return artifact, err
 	}
 
 	return snapshotter.swingStoreExportsHandler.RestoreExport(
 		SwingStoreExportProvider{BlockHeight: blockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readNextArtifact},
-		SwingStoreRestoreOptions{ArtifactMode: SwingStoreArtifactModeOperational, ExportDataMode: SwingStoreExportDataModeAll},
+		SwingStoreRestoreOptions{
+			ArtifactMode:   restoreArtifactMode,
+			ExportDataMode: SwingStoreExportDataModeAll,
+			ResumeDir:      snapshotter.restoreDir,
+		},
 	)
 }
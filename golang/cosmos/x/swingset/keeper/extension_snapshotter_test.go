@@ -1,9 +1,16 @@
 package keeper
 
 import (
+	"bytes"
+	"errors"
 	"io"
 	"testing"
 
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/klauspost/compress/zstd"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -26,7 +33,7 @@ func TestExtensionSnapshotterInProgress(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportStarted()
+	err = extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -46,7 +53,7 @@ func TestExtensionSnapshotterInProgress(t *testing.T) {
 	}
 
 	close(ch)
-	err = WaitUntilSwingStoreExportDone()
+	err = extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -55,7 +62,7 @@ func TestExtensionSnapshotterInProgress(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportDone()
+	err = extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -80,7 +87,7 @@ func TestExtensionSnapshotterSecondCommit(t *testing.T) {
 	}
 
 	// First run through app.Commit()
-	err := WaitUntilSwingStoreExportStarted()
+	err := extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -90,15 +97,227 @@ func TestExtensionSnapshotterSecondCommit(t *testing.T) {
 	}
 
 	// Second run through app.Commit() - should return right away
-	err = WaitUntilSwingStoreExportStarted()
+	err = extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// close the signaling channel to let goroutine exit
 	close(ch)
-	err = WaitUntilSwingStoreExportDone()
+	err = extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
 }
+
+func TestExtensionSnapshotterPayloadFormats(t *testing.T) {
+	artifacts := []types.SwingStoreArtifact{
+		{Name: "snapshot.v1.42", Data: bytes.Repeat([]byte("heap snapshot "), 1000)},
+		{Name: "transcript.v1.1.2", Data: []byte("transcript data")},
+	}
+
+	// Write the artifacts as the payloads of a snapshot.
+	extensionSnapshotter := newTestExtensionSnapshotter()
+	var payloads [][]byte
+	extensionSnapshotter.activeSnapshot = &snapshotDetails{
+		blockHeight: 42,
+		payloadWriter: func(payload []byte) error {
+			payloads = append(payloads, payload)
+			return nil
+		},
+	}
+	remaining := artifacts
+	err := extensionSnapshotter.OnExportRetrieved(SwingStoreExportProvider{
+		BlockHeight:         42,
+		GetExportDataReader: func() (agoric.KVEntryReader, error) { return nil, nil },
+		ReadNextArtifact: func() (types.SwingStoreArtifact, error) {
+			if len(remaining) == 0 {
+				return types.SwingStoreArtifact{}, io.EOF
+			}
+			artifact := remaining[0]
+			remaining = remaining[1:]
+			return artifact, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payloads) != len(artifacts) {
+		t.Fatalf("got %d payloads, want %d", len(payloads), len(artifacts))
+	}
+	if len(payloads[0]) >= len(artifacts[0].Data) {
+		t.Errorf("payload of %d bytes is not compressed", len(payloads[0]))
+	}
+
+	restore := func(format uint32, payloads [][]byte) ([]types.SwingStoreArtifact, error) {
+		var restored []types.SwingStoreArtifact
+		extensionSnapshotter.swingStoreExportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+			exportDir := action.(*swingStoreRestoreExportAction).Args[0].ExportDir
+			provider, err := OpenSwingStoreExportDirectory(exportDir)
+			if err != nil {
+				return "", err
+			}
+			for {
+				artifact, err := provider.ReadNextArtifact()
+				if err == io.EOF {
+					return "", nil
+				} else if err != nil {
+					return "", err
+				}
+				restored = append(restored, artifact)
+			}
+		}
+		err := extensionSnapshotter.RestoreExtension(42, format, func() ([]byte, error) {
+			if len(payloads) == 0 {
+				return nil, io.EOF
+			}
+			payload := payloads[0]
+			payloads = payloads[1:]
+			return payload, nil
+		})
+		return restored, err
+	}
+	extensionSnapshotter.getSwingStoreExportDataShadowCopyReader = func(height int64) agoric.KVEntryReader {
+		return nil
+	}
+
+	restored, err := restore(SnapshotFormatZstd, payloads)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restored) != len(artifacts) || !bytes.Equal(restored[0].Data, artifacts[0].Data) || restored[1].Name != artifacts[1].Name {
+		t.Errorf("got restored artifacts %v, want %v", restored, artifacts)
+	}
+
+	// The uncompressed format is still restored.
+	var uncompressedPayloads [][]byte
+	for _, artifact := range artifacts {
+		payload, err := artifact.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		uncompressedPayloads = append(uncompressedPayloads, payload)
+	}
+	restored, err = restore(SnapshotFormatUncompressed, uncompressedPayloads)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restored) != len(artifacts) || !bytes.Equal(restored[1].Data, artifacts[1].Data) {
+		t.Errorf("got restored artifacts %v, want %v", restored, artifacts)
+	}
+
+	if _, err := restore(SnapshotFormatZstd, uncompressedPayloads); err == nil {
+		t.Error("wanted error for uncompressed payloads in the zstd format")
+	}
+	if _, err := restore(99, payloads); err == nil {
+		t.Error("wanted error for unknown format")
+	}
+
+	// Payloads decompressing beyond the bound are rejected.
+	extensionSnapshotter.maxPayloadSize = uint64(len(artifacts[0].Data))
+	if _, err := restore(SnapshotFormatZstd, payloads); !errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+		t.Errorf("got error %v for an oversized payload, want %v", err, zstd.ErrDecoderSizeExceeded)
+	}
+}
+
+func TestExtensionSnapshotterArtifactModes(t *testing.T) {
+	extensionSnapshotter := newTestExtensionSnapshotter()
+	if format := extensionSnapshotter.SnapshotFormat(); format != SnapshotFormatZstd {
+		t.Errorf("got default snapshot format %d, want %d", format, SnapshotFormatZstd)
+	}
+	extensionSnapshotter.artifactMode = SwingStoreArtifactModeReplay
+	if format := extensionSnapshotter.SnapshotFormat(); format != SnapshotFormatZstdReplay {
+		t.Errorf("got replay snapshot format %d, want %d", format, SnapshotFormatZstdReplay)
+	}
+
+	var exportMode string
+	extensionSnapshotter.swingStoreExportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+		if initiateAction, ok := action.(*swingStoreInitiateExportAction); ok {
+			exportMode = initiateAction.Args[0].ArtifactMode
+		}
+		return "", nil
+	}
+	extensionSnapshotter.takeAppSnapshot = func(height int64) {}
+	if err := extensionSnapshotter.InitiateSnapshot(123); err != nil {
+		t.Fatal(err)
+	}
+	if err := extensionSnapshotter.swingStoreExportsHandler.WaitUntilSwingStoreExportDone(); err != nil {
+		t.Fatal(err)
+	}
+	if exportMode != SwingStoreArtifactModeReplay {
+		t.Errorf("got export artifact mode %q, want %q", exportMode, SwingStoreArtifactModeReplay)
+	}
+
+	extensionSnapshotter.getSwingStoreExportDataShadowCopyReader = func(height int64) agoric.KVEntryReader {
+		return nil
+	}
+	restore := func(restoreArtifactMode string, format uint32) (string, error) {
+		extensionSnapshotter.restoreArtifactMode = restoreArtifactMode
+		var importMode string
+		extensionSnapshotter.swingStoreExportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+			importMode = action.(*swingStoreRestoreExportAction).Args[0].ArtifactMode
+			return "", nil
+		}
+		err := extensionSnapshotter.RestoreExtension(42, format, func() ([]byte, error) {
+			return nil, io.EOF
+		})
+		return importMode, err
+	}
+
+	// A richer snapshot is restored at the restore artifact mode.
+	for _, format := range []uint32{SnapshotFormatUncompressed, SnapshotFormatZstd, SnapshotFormatZstdReplay, SnapshotFormatZstdArchival} {
+		importMode, err := restore("", format)
+		if err != nil {
+			t.Fatalf("format %d: %v", format, err)
+		}
+		if importMode != SwingStoreArtifactModeOperational {
+			t.Errorf("format %d: got import artifact mode %q, want %q", format, importMode, SwingStoreArtifactModeOperational)
+		}
+	}
+	importMode, err := restore(SwingStoreArtifactModeReplay, SnapshotFormatZstdArchival)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if importMode != SwingStoreArtifactModeReplay {
+		t.Errorf("got import artifact mode %q, want %q", importMode, SwingStoreArtifactModeReplay)
+	}
+
+	// A poorer snapshot is rejected.
+	if _, err := restore(SwingStoreArtifactModeReplay, SnapshotFormatZstd); err == nil {
+		t.Error("wanted error for operational snapshot restored in replay mode")
+	}
+	if _, err := restore(SwingStoreArtifactModeArchival, SnapshotFormatZstdReplay); err == nil {
+		t.Error("wanted error for replay snapshot restored in archival mode")
+	}
+}
+
+func TestExtensionSnapshotterSupportedFormats(t *testing.T) {
+	extensionSnapshotter := newTestExtensionSnapshotter()
+	allFormats := []uint32{SnapshotFormatUncompressed, SnapshotFormatZstd, SnapshotFormatZstdReplay, SnapshotFormatZstdArchival}
+	for _, tt := range []struct {
+		artifactMode        string
+		restoreArtifactMode string
+		supported           []uint32
+	}{
+		{"", "", allFormats},
+		{SwingStoreArtifactModeReplay, SwingStoreArtifactModeReplay, []uint32{SnapshotFormatZstdReplay, SnapshotFormatZstdArchival}},
+		{SwingStoreArtifactModeArchival, SwingStoreArtifactModeArchival, []uint32{SnapshotFormatZstdArchival}},
+		// The format of the snapshots created by the node remains supported.
+		{"", SwingStoreArtifactModeArchival, []uint32{SnapshotFormatZstd, SnapshotFormatZstdArchival}},
+	} {
+		extensionSnapshotter.artifactMode = tt.artifactMode
+		extensionSnapshotter.restoreArtifactMode = tt.restoreArtifactMode
+		for _, format := range allFormats {
+			want := false
+			for _, supported := range tt.supported {
+				want = want || format == supported
+			}
+			// The snapshot manager rejects unsupported formats with
+			// ErrUnknownFormat before calling RestoreExtension.
+			if got := snapshots.IsFormatSupported(extensionSnapshotter, format); got != want {
+				t.Errorf("snapshot mode %q, restore mode %q: got format %d supported %t, want %t",
+					tt.artifactMode, tt.restoreArtifactMode, format, got, want)
+			}
+		}
+	}
+}
@@ -2,12 +2,19 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
@@ -61,3 +68,210 @@ func (k Querier) Mailbox(c context.Context, req *types.QueryMailboxRequest) (*ty
 		Value: value,
 	}, nil
 }
+
+func (k Querier) Egresses(c context.Context, req *types.QueryEgressesRequest) (*types.QueryEgressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	egresses := []types.Egress{}
+	pageRes, err := k.vstorageKeeper.PaginateChildren(ctx, StoragePathEgress, req.Pagination, func(entry agoric.KVEntry, accumulate bool) (bool, error) {
+		if !entry.HasValue() {
+			return false, nil
+		}
+		var egress types.Egress
+		if err := json.Unmarshal([]byte(entry.StringValue()), &egress); err != nil {
+			return false, err
+		}
+		if req.PowerFlag != "" && !egress.HasPowerFlag(req.PowerFlag) {
+			return false, nil
+		}
+		if accumulate {
+			egresses = append(egresses, egress)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEgressesResponse{
+		Egresses:   egresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) Mailboxes(c context.Context, req *types.QueryMailboxesRequest) (*types.QueryMailboxesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	mailboxes := []types.PeerMailbox{}
+	pageRes, err := k.vstorageKeeper.PaginateChildren(ctx, StoragePathMailbox, req.Pagination, func(entry agoric.KVEntry, accumulate bool) (bool, error) {
+		if !entry.HasValue() {
+			return false, nil
+		}
+		if accumulate {
+			mailboxes = append(mailboxes, types.PeerMailbox{
+				Peer:  strings.TrimPrefix(entry.Key(), StoragePathMailbox+"."),
+				Value: entry.StringValue(),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMailboxesResponse{
+		Mailboxes:  mailboxes,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) CoreEvalOutcome(c context.Context, req *types.QueryCoreEvalOutcomeRequest) (*types.QueryCoreEvalOutcomeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	outcome, found := k.GetCoreEvalOutcome(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "core eval outcome not found")
+	}
+
+	return &types.QueryCoreEvalOutcomeResponse{
+		Outcome: outcome,
+	}, nil
+}
+
+func (k Querier) Bundles(c context.Context, req *types.QueryBundlesRequest) (*types.QueryBundlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var bundles []types.InstalledBundle
+	pageRes, err := query.Paginate(k.getInstalledBundleStore(ctx), req.Pagination, func(key []byte, value []byte) error {
+		bundle := types.InstalledBundle{}
+		if err := k.cdc.Unmarshal(value, &bundle); err != nil {
+			return err
+		}
+		bundles = append(bundles, bundle)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBundlesResponse{
+		Bundles:    bundles,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) Bundle(c context.Context, req *types.QueryBundleRequest) (*types.QueryBundleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bundle, found := k.GetInstalledBundle(ctx, req.BundleId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "bundle not found")
+	}
+
+	return &types.QueryBundleResponse{
+		Bundle: bundle,
+	}, nil
+}
+
+func (k Querier) InboundPause(c context.Context, req *types.QueryInboundPauseRequest) (*types.QueryInboundPauseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pause := k.GetInboundPause(ctx)
+
+	return &types.QueryInboundPauseResponse{
+		Pause:  pause,
+		Active: pause.IsActive(ctx.BlockHeight()),
+	}, nil
+}
+
+func (k Querier) BlockStats(c context.Context, req *types.QueryBlockStatsRequest) (*types.QueryBlockStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MinHeight < 0 || req.MaxHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights cannot be negative")
+	}
+	if req.MaxHeight > 0 && req.MinHeight > req.MaxHeight {
+		return nil, status.Error(codes.InvalidArgument, "min height exceeds max height")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stats := []types.BlockStats{}
+	pageRes, err := query.FilteredPaginate(k.getBlockStatsStore(ctx), req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		height := int64(binary.BigEndian.Uint64(key))
+		if height < req.MinHeight || (req.MaxHeight > 0 && height > req.MaxHeight) {
+			return false, nil
+		}
+		if accumulate {
+			blockStats := types.BlockStats{}
+			if err := k.cdc.Unmarshal(value, &blockStats); err != nil {
+				return false, err
+			}
+			stats = append(stats, blockStats)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlockStatsResponse{
+		Stats:      stats,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) ExportDataCheck(c context.Context, req *types.QueryExportDataCheckRequest) (*types.QueryExportDataCheckResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	check, mismatches, interval := k.GetExportDataCheck()
+	return &types.QueryExportDataCheckResponse{
+		Check:      check,
+		Mismatches: mismatches,
+		Interval:   interval,
+	}, nil
+}
+
+func (k Querier) EstimateBeans(c context.Context, req *types.QueryEstimateBeansRequest) (*types.QueryEstimateBeansResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	beansPerUnit := k.GetBeansPerUnit(ctx)
+	beans := sdkmath.ZeroUint()
+	for i, msgAny := range req.Msgs {
+		var msg sdk.Msg
+		if err := k.cdc.UnpackAny(msgAny, &msg); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "msg %d: %s", i, err)
+		}
+		if camsg, ok := msg.(vm.ControllerAdmissionMsg); ok {
+			beans = beans.Add(types.EstimateAdmissionBeans(beansPerUnit, camsg))
+		}
+	}
+
+	return &types.QueryEstimateBeansResponse{
+		Beans: beans,
+		Costs: k.EstimateBeansCosts(ctx, beans),
+	}, nil
+}
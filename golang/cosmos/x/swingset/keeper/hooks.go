@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Hooks wraps the swingset Keeper to implement the governance hooks.
+type Hooks struct {
+	k Keeper
+}
+
+var _ govtypes.GovHooks = Hooks{}
+
+// Hooks returns the governance hooks for the swingset Keeper.
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterProposalVotingPeriodEnded associates the MsgCoreEvals executed by a
+// passed proposal with its ID, so that their outcome can be queried.
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.k.assignPendingCoreEvals(ctx, proposalID)
+}
+
+func (h Hooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {}
+
+func (h Hooks) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+}
+
+func (h Hooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {}
+
+func (h Hooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {}
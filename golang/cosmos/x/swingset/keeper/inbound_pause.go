@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// GetInboundPause returns the governance pause of inbound messages, which is
+// empty if none has been set.
+func (k Keeper) GetInboundPause(ctx sdk.Context) types.InboundPause {
+	pause := types.InboundPause{}
+	bz := ctx.KVStore(k.storeKey).Get([]byte(inboundPauseKey))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &pause)
+	}
+	return pause
+}
+
+// SetInboundPause replaces the governance pause of inbound messages. An empty
+// pause is deleted rather than stored.
+func (k Keeper) SetInboundPause(ctx sdk.Context, pause types.InboundPause) {
+	store := ctx.KVStore(k.storeKey)
+	if !pause.All && len(pause.MsgTypeURLs) == 0 {
+		store.Delete([]byte(inboundPauseKey))
+		return
+	}
+	store.Set([]byte(inboundPauseKey), k.cdc.MustMarshal(&pause))
+}
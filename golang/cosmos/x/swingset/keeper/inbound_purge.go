@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// queuedRecord is the part of an inbound queue record matched by a purge.
+type queuedRecord struct {
+	Action struct {
+		Type string `json:"type"`
+	} `json:"action"`
+	Context types.ActionContext `json:"context"`
+}
+
+// purgedRecord is the archive of a purged inbound queue record, appended to the
+// purgedActions queue in vstorage.
+type purgedRecord struct {
+	Queue       string          `json:"queue"`
+	Index       uint64          `json:"index"`
+	PurgeHeight int64           `json:"purgeHeight"`
+	Record      json.RawMessage `json:"record"`
+}
+
+type purgedRecordSummary struct {
+	Index      uint64              `json:"index"`
+	ActionType string              `json:"actionType"`
+	Context    types.ActionContext `json:"context"`
+}
+
+type inboundQueuePurgedAction struct {
+	*vm.ActionHeader `actionType:"INBOUND_QUEUE_PURGED"`
+	Queue            string                `json:"queue"`
+	Purged           []purgedRecordSummary `json:"purged"`
+	// The purged records are archived at [ArchiveStart, ArchiveEnd) of the
+	// purgedActions queue.
+	ArchiveStart uint64 `json:"archiveStart"`
+	ArchiveEnd   uint64 `json:"archiveEnd"`
+}
+
+// PurgeInboundQueue removes the records matching the criteria of a governance
+// MsgPurgeInboundQueue from an inbound queue, returning how many were removed.
+// The remaining records keep their order and are moved towards the tail of the
+// queue, whose head is advanced past the freed indices, so that the controller
+// finds a contiguous queue and the tail keeps increasing. The removed records
+// are archived in the purgedActions queue and reported to the controller with
+// a high-priority INBOUND_QUEUE_PURGED action.
+func (k Keeper) PurgeInboundQueue(ctx sdk.Context, msg *types.MsgPurgeInboundQueue) (uint64, error) {
+	queuePath := msg.QueuePath()
+	criteria := msg.Criteria
+	var lpSender []byte
+	if criteria.Sender != "" {
+		sender, err := sdk.AccAddressFromBech32(criteria.Sender)
+		if err != nil {
+			return 0, err
+		}
+		lpSender = address.MustLengthPrefix(sender)
+	}
+
+	headInt, err := k.vstorageKeeper.GetIntValue(ctx, queuePath+".head")
+	if err != nil {
+		return 0, err
+	}
+	tailInt, err := k.vstorageKeeper.GetIntValue(ctx, queuePath+".tail")
+	if err != nil {
+		return 0, err
+	}
+	head, tail := headInt.Uint64(), tailInt.Uint64()
+
+	type indexedValue struct {
+		index uint64
+		value string
+	}
+	var kept, removed []indexedValue
+	var summaries []purgedRecordSummary
+	for index := head; index < tail; index++ {
+		value := k.vstorageKeeper.GetEntry(ctx, fmt.Sprintf("%s.%d", queuePath, index)).StringValue()
+		var record queuedRecord
+		if err := json.Unmarshal([]byte(value), &record); err != nil {
+			// A record which can't be decoded only matches on its index.
+			record = queuedRecord{}
+		}
+
+		matches := index >= criteria.StartIndex &&
+			(criteria.EndIndex == 0 || index < criteria.EndIndex) &&
+			(criteria.ActionType == "" || record.Action.Type == criteria.ActionType) &&
+			(criteria.TxHash == "" || record.Context.TxHash == criteria.TxHash) &&
+			(lpSender == nil || bytes.Equal(k.inboundSenderOf(ctx, index), lpSender))
+		if !matches {
+			kept = append(kept, indexedValue{index, value})
+			continue
+		}
+		removed = append(removed, indexedValue{index, value})
+		summaries = append(summaries, purgedRecordSummary{
+			Index:      index,
+			ActionType: record.Action.Type,
+			Context:    record.Context,
+		})
+	}
+	if len(removed) == 0 {
+		return 0, nil
+	}
+
+	// Archive the removed records.
+	archiveStartInt, err := k.vstorageKeeper.GetIntValue(ctx, StoragePathPurgedActions+".tail")
+	if err != nil {
+		return 0, err
+	}
+	archiveStart := archiveStartInt.Uint64()
+	for _, r := range removed {
+		bz, err := json.Marshal(purgedRecord{
+			Queue:       queuePath,
+			Index:       r.index,
+			PurgeHeight: ctx.BlockHeight(),
+			Record:      json.RawMessage(r.value),
+		})
+		if err != nil {
+			return 0, err
+		}
+		if err := k.vstorageKeeper.PushQueueItem(ctx, StoragePathPurgedActions, string(bz)); err != nil {
+			return 0, err
+		}
+	}
+
+	// Compact the queue against its tail.
+	newHead := head + uint64(len(removed))
+	moved := make(map[uint64]uint64, len(kept))
+	for i, r := range kept {
+		to := newHead + uint64(i)
+		moved[r.index] = to
+		if to != r.index {
+			k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(fmt.Sprintf("%s.%d", queuePath, to), r.value))
+		}
+	}
+	for index := head; index < newHead; index++ {
+		k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntryWithNoValue(fmt.Sprintf("%s.%d", queuePath, index)))
+	}
+	k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(queuePath+".head", fmt.Sprint(newHead)))
+	if queuePath == StoragePathActionQueue {
+		k.reindexInboundSenders(ctx, head, tail, moved)
+	}
+
+	// Tell the controller, with a synthesized txHash unique to this purge.
+	action := inboundQueuePurgedAction{
+		Queue:        queuePath,
+		Purged:       summaries,
+		ArchiveStart: archiveStart,
+		ArchiveEnd:   archiveStart + uint64(len(removed)),
+	}
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxHashContextKey, fmt.Sprintf("x/gov/purge/%d", archiveStart)))
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxMsgIdxContextKey, 0))
+	if err := k.PushHighPriorityAction(ctx, action); err != nil {
+		return 0, err
+	}
+	return uint64(len(removed)), nil
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// The senders of actions in the actionQueue are indexed in the module store so
+// that per-sender limits can be enforced without decoding the queue records.
+// Each entry of the index is keyed by the queue index of the record, and the
+// count of records per sender (and per sender and message type) is maintained
+// alongside. Entries for records consumed by the controller are pruned after
+// END_BLOCK, and the index is part of the genesis state.
+
+func (k Keeper) getInboundSenderStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(inboundSenderKeyPrefix))
+}
+
+func (k Keeper) getInboundSenderCountStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(inboundSenderCountKeyPrefix))
+}
+
+// inboundSenderCountKey returns the count key for the sender and message type,
+// or for all the messages of the sender if msgType is empty.
+func inboundSenderCountKey(lpSender []byte, msgType string) []byte {
+	key := make([]byte, 0, len(lpSender)+len(msgType))
+	key = append(key, lpSender...)
+	return append(key, []byte(msgType)...)
+}
+
+func (k Keeper) addInboundSenderCount(ctx sdk.Context, key []byte, delta int64) {
+	store := k.getInboundSenderCountStore(ctx)
+	var count uint64
+	if bz := store.Get(key); bz != nil {
+		count = binary.BigEndian.Uint64(bz)
+	}
+	if delta < 0 && uint64(-delta) >= count {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(int64(count)+delta)))
+}
+
+// PushActionFromSender appends an action from the given sender and message type
+// to the controller's actionQueue, and indexes its sender.
+func (k Keeper) PushActionFromSender(ctx sdk.Context, sender sdk.AccAddress, msgType string, action vm.Action) error {
+	tail, err := k.vstorageKeeper.GetIntValue(ctx, StoragePathActionQueue+".tail")
+	if err != nil {
+		return err
+	}
+	err = k.PushAction(ctx, action)
+	if err != nil {
+		return err
+	}
+
+	lpSender := address.MustLengthPrefix(sender)
+	entry := inboundSenderCountKey(lpSender, msgType)
+	store := k.getInboundSenderStore(ctx)
+	key := sdk.Uint64ToBigEndian(tail.Uint64())
+	if previous := store.Get(key); previous != nil {
+		// The queue indices restart from 0 once the controller drains the queue,
+		// so forget the action previously indexed here.
+		k.forgetInboundSender(ctx, previous)
+	}
+	store.Set(key, entry)
+	k.addInboundSenderCount(ctx, inboundSenderCountKey(lpSender, ""), 1)
+	k.addInboundSenderCount(ctx, entry, 1)
+	return nil
+}
+
+// InboundQueueSenderCount returns the number of actions from the sender in the
+// actionQueue, restricted to the given message type unless it is empty.
+func (k Keeper) InboundQueueSenderCount(ctx sdk.Context, sender sdk.AccAddress, msgType string) (int32, error) {
+	key := inboundSenderCountKey(address.MustLengthPrefix(sender), msgType)
+	bz := k.getInboundSenderCountStore(ctx).Get(key)
+	if bz == nil {
+		return 0, nil
+	}
+	count := binary.BigEndian.Uint64(bz)
+	if count > math.MaxInt32 {
+		return math.MaxInt32, nil
+	}
+	return int32(count), nil
+}
+
+// forgetInboundSender decrements the counts of an index entry.
+func (k Keeper) forgetInboundSender(ctx sdk.Context, entry []byte) {
+	senderLen := int(entry[0]) + 1
+	k.addInboundSenderCount(ctx, entry[:senderLen], -1)
+	k.addInboundSenderCount(ctx, entry, -1)
+}
+
+// PruneInboundSenders removes the index entries of the actions which are no
+// longer in the actionQueue.
+func (k Keeper) PruneInboundSenders(ctx sdk.Context) error {
+	head, err := k.vstorageKeeper.GetIntValue(ctx, StoragePathActionQueue+".head")
+	if err != nil {
+		return err
+	}
+	tail, err := k.vstorageKeeper.GetIntValue(ctx, StoragePathActionQueue+".tail")
+	if err != nil {
+		return err
+	}
+
+	// A drained queue has neither head nor tail, so nothing is left of it.
+	var end []byte
+	if head.LT(tail) {
+		end = sdk.Uint64ToBigEndian(head.Uint64())
+	}
+
+	store := k.getInboundSenderStore(ctx)
+	var keys, entries [][]byte
+	iterator := store.Iterator(nil, end)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		entries = append(entries, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+		k.forgetInboundSender(ctx, entries[i])
+	}
+	return nil
+}
+
+// ExportInboundQueueSenders returns the index of the senders of the actions in
+// the actionQueue, in queue order.
+func (k Keeper) ExportInboundQueueSenders(ctx sdk.Context) []types.InboundQueueSender {
+	senders := []types.InboundQueueSender{}
+	iterator := k.getInboundSenderStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		entry := iterator.Value()
+		senderLen := int(entry[0]) + 1
+		senders = append(senders, types.InboundQueueSender{
+			Index:   binary.BigEndian.Uint64(iterator.Key()),
+			Sender:  sdk.AccAddress(entry[1:senderLen]).String(),
+			MsgType: string(entry[senderLen:]),
+		})
+	}
+	return senders
+}
+
+// ImportInboundQueueSenders indexes the senders of the actions in the
+// actionQueue, as exported by ExportInboundQueueSenders.
+func (k Keeper) ImportInboundQueueSenders(ctx sdk.Context, senders []types.InboundQueueSender) error {
+	store := k.getInboundSenderStore(ctx)
+	for _, sender := range senders {
+		addr, err := sdk.AccAddressFromBech32(sender.Sender)
+		if err != nil {
+			return err
+		}
+		key := sdk.Uint64ToBigEndian(sender.Index)
+		if previous := store.Get(key); previous != nil {
+			k.forgetInboundSender(ctx, previous)
+		}
+		lpSender := address.MustLengthPrefix(addr)
+		entry := inboundSenderCountKey(lpSender, sender.MsgType)
+		store.Set(key, entry)
+		k.addInboundSenderCount(ctx, inboundSenderCountKey(lpSender, ""), 1)
+		k.addInboundSenderCount(ctx, entry, 1)
+	}
+	return nil
+}
+
+// reindexInboundSenders updates the index entries of the actionQueue records in
+// [start, end) after a purge, moving those of the records found in moved to
+// their new queue index and forgetting the others.
+func (k Keeper) reindexInboundSenders(ctx sdk.Context, start, end uint64, moved map[uint64]uint64) {
+	store := k.getInboundSenderStore(ctx)
+	var keys, entries [][]byte
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(start), sdk.Uint64ToBigEndian(end))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		entries = append(entries, iterator.Value())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	for i, key := range keys {
+		entry := entries[i]
+		if to, found := moved[binary.BigEndian.Uint64(key)]; found {
+			store.Set(sdk.Uint64ToBigEndian(to), entry)
+			continue
+		}
+		k.forgetInboundSender(ctx, entry)
+	}
+}
+
+// inboundSenderOf returns the length-prefixed sender of the actionQueue record
+// at the given index, or nil if it is not indexed.
+func (k Keeper) inboundSenderOf(ctx sdk.Context, index uint64) []byte {
+	entry := k.getInboundSenderStore(ctx).Get(sdk.Uint64ToBigEndian(index))
+	if entry == nil {
+		return nil
+	}
+	return entry[:int(entry[0])+1]
+}
@@ -1,12 +1,17 @@
 package keeper
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	stdlog "log"
 	"math"
+	"strings"
+	"sync"
+	"time"
 
+	sdkioerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -29,8 +34,8 @@ import (
 // Top-level paths for chain storage should remain synchronized with
 // packages/internal/src/chain-storage-paths.js
 const (
-	StoragePathActionQueue         = "actionQueue"
-	StoragePathHighPriorityQueue   = "highPriorityQueue"
+	StoragePathActionQueue         = types.InboundQueueAction
+	StoragePathHighPriorityQueue   = types.InboundQueueHighPriority
 	StoragePathHighPrioritySenders = "highPrioritySenders"
 	StoragePathBeansOwing          = "beansOwing"
 	StoragePathEgress              = "egress"
@@ -38,8 +43,14 @@ const (
 	StoragePathCustom              = "published"
 	StoragePathBundles             = "bundles"
 	StoragePathSwingStore          = "swingStore"
+	StoragePathPurgedActions       = "purgedActions"
 )
 
+// BeansOwingLegacyDenom is the denom of the beans owed at the historical
+// beansOwing path of an address, which predates settling beans in other denoms.
+// It is fixed so that changes to the fee unit price never move existing debts.
+const BeansOwingLegacyDenom = "uist"
+
 const (
 	// WalletStoragePathSegment matches the value of WALLET_STORAGE_PATH_SEGMENT
 	// packages/vats/src/core/startWalletFactory.js
@@ -47,8 +58,20 @@ const (
 )
 
 const (
-	stateKey            = "state"
-	swingStoreKeyPrefix = "swingStore."
+	stateKey                    = "state"
+	swingStoreKeyPrefix         = "swingStore."
+	coreEvalSeqKey              = "coreEvalSeq"
+	pendingCoreEvalKeyPrefix    = "pendingCoreEval."
+	coreEvalProposalKeyPrefix   = "coreEvalProposal."
+	coreEvalOutcomeKeyPrefix    = "coreEvalOutcome."
+	bundleUploadKeyPrefix       = "bundleUpload."
+	bundleUploadChunkKeyPrefix  = "bundleUploadChunk."
+	bundleUploadExpiryKeyPrefix = "bundleUploadExpiry."
+	installedBundleKeyPrefix    = "installedBundle."
+	inboundSenderKeyPrefix      = "inboundSender."
+	inboundSenderCountKeyPrefix = "inboundSenderCount."
+	inboundPauseKey             = "inboundPause"
+	blockStatsKeyPrefix         = "blockStats."
 )
 
 // Keeper maintains the link to data vstorage and exposes getter/setter methods for the various parts of the state machine
@@ -59,11 +82,32 @@ type Keeper struct {
 
 	accountKeeper    types.AccountKeeper
 	bankKeeper       bankkeeper.Keeper
+	feegrantKeeper   types.FeegrantKeeper
 	vstorageKeeper   vstoragekeeper.Keeper
 	feeCollectorName string
 
+	// authority is the address allowed to execute governance messages,
+	// typically the x/gov module account.
+	authority string
+
 	// CallToController dispatches a message to the controlling process
 	callToController func(ctx sdk.Context, str string) (string, error)
+
+	// exportDataCheck is the node-local state of the export data checks.
+	exportDataCheck *exportDataCheckState
+
+	// endBlock is the height and time of the last ended block.
+	endBlock *endBlockState
+}
+
+// endBlockState is the height and time of the last block ended by EndBlock,
+// shared by all copies of a Keeper. It provides the context of the following
+// COMMIT_BLOCK and AFTER_COMMIT_BLOCK actions, which are sent outside of any
+// block.
+type endBlockState struct {
+	mutex  sync.Mutex
+	height int64
+	time   int64
 }
 
 var _ types.SwingSetKeeper = &Keeper{}
@@ -73,7 +117,9 @@ var _ ante.SwingsetKeeper = &Keeper{}
 func NewKeeper(
 	cdc codec.Codec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper, bankKeeper bankkeeper.Keeper,
+	feegrantKeeper types.FeegrantKeeper,
 	vstorageKeeper vstoragekeeper.Keeper, feeCollectorName string,
+	authority string,
 	callToController func(ctx sdk.Context, str string) (string, error),
 ) Keeper {
 
@@ -88,12 +134,21 @@ func NewKeeper(
 		paramSpace:       paramSpace,
 		accountKeeper:    accountKeeper,
 		bankKeeper:       bankKeeper,
+		feegrantKeeper:   feegrantKeeper,
 		vstorageKeeper:   vstorageKeeper,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 		callToController: callToController,
+		exportDataCheck:  &exportDataCheckState{},
+		endBlock:         &endBlockState{},
 	}
 }
 
+// GetAuthority returns the address allowed to execute governance messages.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func populateAction(ctx sdk.Context, action vm.Action) (vm.Action, error) {
 	action = vm.PopulateAction(ctx, action)
 	ah := action.GetActionHeader()
@@ -224,6 +279,16 @@ func (k Keeper) UpdateQueueAllowed(ctx sdk.Context) error {
 		{Key: types.QueueInbound, Size_: inboundQueueAllowed},
 		{Key: types.QueueInboundMempool, Size_: inboundMempoolQueueAllowed},
 	}
+	// The per-tx allowance doesn't depend on the queue size, but is kept with
+	// the other allowances so the ante handler only needs to consult the state.
+	// The same goes for the per-sender limits.
+	for _, qm := range params.QueueMax {
+		if qm.Key == types.QueueInboundPerTx ||
+			qm.Key == types.QueueInboundPerSender ||
+			strings.HasPrefix(qm.Key, types.QueueInboundPerSenderMsgTypePrefix) {
+			state.QueueAllowed = append(state.QueueAllowed, qm)
+		}
+	}
 	k.SetState(ctx, state)
 
 	return nil
@@ -245,6 +310,25 @@ func (k Keeper) BlockingSend(ctx sdk.Context, action vm.Action) (string, error)
 	return k.callToController(ctx, string(bz))
 }
 
+// SetEndBlockContext saves the height and time of the block being ended.
+func (k Keeper) SetEndBlockContext(ctx sdk.Context) {
+	k.endBlock.mutex.Lock()
+	defer k.endBlock.mutex.Unlock()
+	k.endBlock.height = ctx.BlockHeight()
+	k.endBlock.time = ctx.BlockTime().Unix()
+}
+
+// GetEndBlockContext returns a context without stores of the height and time
+// of the last ended block.
+func (k Keeper) GetEndBlockContext() sdk.Context {
+	k.endBlock.mutex.Lock()
+	defer k.endBlock.mutex.Unlock()
+	return sdk.Context{}.
+		WithContext(context.Background()).
+		WithBlockHeight(k.endBlock.height).
+		WithBlockTime(time.Unix(k.endBlock.time, 0))
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
 	return params
@@ -279,14 +363,38 @@ func (k Keeper) GetBeansPerUnit(ctx sdk.Context) map[string]sdkmath.Uint {
 	return beansPerUnit
 }
 
-func getBeansOwingPathForAddress(addr sdk.AccAddress) string {
-	return StoragePathBeansOwing + "." + addr.String()
+// getBeansOwingPath returns the vstorage path of the beans the given address
+// owes in the given denom. Beans owed in BeansOwingLegacyDenom, or without any
+// fee unit price, are kept at the historical path of the address, and those
+// owed in other denoms beneath it.
+func getBeansOwingPath(addr sdk.AccAddress, denom string) string {
+	path := StoragePathBeansOwing + "." + addr.String()
+	if denom == "" || denom == BeansOwingLegacyDenom {
+		return path
+	}
+	return path + "." + denomPathSegment(denom)
+}
+
+// denomPathSegment encodes a denom as a vstorage path segment, escaping any
+// byte other than ASCII letters, digits and underscores as "-" followed by its
+// two hex digits.
+func denomPathSegment(denom string) string {
+	var sb strings.Builder
+	for i := 0; i < len(denom); i++ {
+		c := denom[i]
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+			sb.WriteByte(c)
+		} else {
+			fmt.Fprintf(&sb, "-%02x", c)
+		}
+	}
+	return sb.String()
 }
 
 // GetBeansOwing returns the number of beans that the given address owes to
-// the FeeAccount but has not yet paid.
-func (k Keeper) GetBeansOwing(ctx sdk.Context, addr sdk.AccAddress) sdkmath.Uint {
-	path := getBeansOwingPathForAddress(addr)
+// the FeeAccount in the given denom but has not yet paid.
+func (k Keeper) GetBeansOwing(ctx sdk.Context, addr sdk.AccAddress, denom string) sdkmath.Uint {
+	path := getBeansOwingPath(addr, denom)
 	entry := k.vstorageKeeper.GetEntry(ctx, path)
 	if !entry.HasValue() {
 		return sdkmath.ZeroUint()
@@ -295,19 +403,35 @@ func (k Keeper) GetBeansOwing(ctx sdk.Context, addr sdk.AccAddress) sdkmath.Uint
 }
 
 // SetBeansOwing sets the number of beans that the given address owes to the
-// feeCollector but has not yet paid.
-func (k Keeper) SetBeansOwing(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.Uint) {
-	path := getBeansOwingPathForAddress(addr)
+// feeCollector in the given denom but has not yet paid.
+func (k Keeper) SetBeansOwing(ctx sdk.Context, addr sdk.AccAddress, denom string, beans sdkmath.Uint) {
+	path := getBeansOwingPath(addr, denom)
 	k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(path, beans.String()))
 }
 
 // ChargeBeans charges the given address the given number of beans.  It divides
 // the beans into the number to debit immediately vs. the number to store in the
-// beansOwing.
+// beansOwing. The beans are settled in the denom of the fee unit price chosen
+// for the Tx (see vm.WithFeeDenom), or else Params.DefaultFeeDenom. If the Tx fees
+// are paid by a feegrant allowance (see vm.WithFeeGrant), the debit is drawn
+// from the same allowance.
 func (k Keeper) ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.Uint) error {
 	beansPerUnit := k.GetBeansPerUnit(ctx)
 
-	wasOwing := k.GetBeansOwing(ctx, addr)
+	params := k.GetParams(ctx)
+	price, ok := params.FeeUnitPriceIn(vm.FeeDenom(ctx))
+	if !ok {
+		price, ok = params.FeeUnitPriceIn("")
+	}
+	if !ok {
+		// Without a price the beans cannot be debited, but they are still
+		// recorded as owed in the default denom.
+		denom := params.DefaultFeeDenom()
+		k.SetBeansOwing(ctx, addr, denom, k.GetBeansOwing(ctx, addr, denom).Add(beans))
+		return nil
+	}
+
+	wasOwing := k.GetBeansOwing(ctx, addr, price.Denom)
 	nowOwing := wasOwing.Add(beans)
 
 	// Actually debit immediately in integer multiples of the minimum debit, since
@@ -319,14 +443,13 @@ func (k Keeper) ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.
 	// Convert the debit to coins.
 	beansPerFeeUnitDec := sdk.NewDecFromBigInt(beansPerUnit[types.BeansPerFeeUnit].BigInt())
 	beansToDebitDec := sdk.NewDecFromBigInt(beansToDebit.BigInt())
-	feeUnitPrice := k.GetParams(ctx).FeeUnitPrice
-	feeDecCoins := sdk.NewDecCoinsFromCoins(feeUnitPrice...).MulDec(beansToDebitDec).QuoDec(beansPerFeeUnitDec)
+	feeDecCoins := sdk.NewDecCoinsFromCoins(price).MulDec(beansToDebitDec).QuoDec(beansPerFeeUnitDec)
 
 	// Charge the account immediately if they owe more than BeansPerMinFeeDebit.
 	// NOTE: We assume that BeansPerMinFeeDebit is a multiple of BeansPerFeeUnit.
 	feeCoins, _ := feeDecCoins.TruncateDecimal()
 	if !feeCoins.IsZero() {
-		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, k.feeCollectorName, feeCoins)
+		err := k.debitFees(ctx, addr, feeCoins)
 		if err != nil {
 			return err
 		}
@@ -334,7 +457,50 @@ func (k Keeper) ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.
 
 	// Record the new owing value, whether we have debited immediately or not
 	// (i.e. there is more owing than before, but not enough to debit).
-	k.SetBeansOwing(ctx, addr, remainderOwing)
+	k.SetBeansOwing(ctx, addr, price.Denom, remainderOwing)
+	return nil
+}
+
+// EstimateBeansCosts returns the cost of the given beans in each denom of the
+// fee unit price, rounded up.
+func (k Keeper) EstimateBeansCosts(ctx sdk.Context, beans sdkmath.Uint) sdk.Coins {
+	beansPerFeeUnit := k.GetBeansPerUnit(ctx)[types.BeansPerFeeUnit]
+	costs := sdk.NewCoins()
+	if beansPerFeeUnit.IsNil() || beansPerFeeUnit.IsZero() {
+		return costs
+	}
+	beansPerFeeUnitDec := sdk.NewDecFromBigInt(beansPerFeeUnit.BigInt())
+	beansDec := sdk.NewDecFromBigInt(beans.BigInt())
+	for _, price := range k.GetParams(ctx).FeeUnitPrice {
+		if !price.Amount.IsPositive() {
+			continue
+		}
+		cost := sdk.NewDecFromInt(price.Amount).Mul(beansDec).Quo(beansPerFeeUnitDec).Ceil().TruncateInt()
+		costs = costs.Add(sdk.NewCoin(price.Denom, cost))
+	}
+	return costs
+}
+
+// debitFees sends fees owed by the given address to the fee collector, from
+// the feegrant allowance granted to the address for the current Tx if any.
+func (k Keeper) debitFees(ctx sdk.Context, addr sdk.AccAddress, fees sdk.Coins) error {
+	payer := addr
+	granter, msgs := vm.FeeGrant(ctx)
+	if granter != nil && !granter.Equals(addr) {
+		err := k.feegrantKeeper.UseGrantedFees(ctx, granter, addr, fees, msgs)
+		if err != nil {
+			return sdkioerrors.Wrapf(err, "%s does not allow to pay bean charges for %s", granter, addr)
+		}
+		payer = granter
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, k.feeCollectorName, fees)
+	if err != nil {
+		return err
+	}
+	if !payer.Equals(addr) {
+		ctx.EventManager().EmitEvent(types.NewGrantedBeanChargeEvent(payer, addr, fees))
+	}
 	return nil
 }
 
@@ -388,6 +554,14 @@ func calculateFees(balances sdk.Coins, submitter, addr sdk.AccAddress, powerFlag
 		return nil, fmt.Errorf("must specify powerFlags for fee-based provisioning")
 	}
 
+	return powerFlagsFee(powerFlags, powerFlagFees)
+}
+
+// powerFlagsFee returns the sum of the fees of the power flags, or an error if
+// any of them isn't in the fee menu.
+func powerFlagsFee(powerFlags []string, powerFlagFees []types.PowerFlagFee) (sdk.Coins, error) {
+	fees := sdk.NewCoins()
+
 	// Collate the power flags into a map of power flags to the fee coins.
 	feeMenu := makeFeeMenu(powerFlagFees)
 
@@ -1,16 +1,32 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	prefixstore "github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -191,6 +207,9 @@ func Test_calculateFees(t *testing.T) {
 
 var (
 	swingsetStoreKey = storetypes.NewKVStoreKey(types.StoreKey)
+	vstorageStoreKey = storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+	paramsStoreKey   = storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey  = storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
 )
 
 func makeTestStore() sdk.KVStore {
@@ -258,3 +277,955 @@ func TestSwingStore(t *testing.T) {
 		t.Errorf("got export %q, want %q", gotEntries, expectedEntries)
 	}
 }
+
+// makeTestKeeper returns a Keeper backed only by fresh swingset, vstorage and
+// params stores, with default params, along with a context at the given block
+// height.
+func makeTestKeeper(height int64) (Keeper, sdk.Context) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		panic(err)
+	}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := Keeper{
+		storeKey:        swingsetStoreKey,
+		cdc:             cdc,
+		paramSpace:      paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey, types.ModuleName).WithKeyTable(types.ParamKeyTable()),
+		vstorageKeeper:  vstoragekeeper.NewKeeper(vstorageStoreKey),
+		exportDataCheck: &exportDataCheckState{},
+		endBlock:        &endBlockState{},
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: height}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
+	return k, ctx
+}
+
+func TestBundleUpload(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	submitter := sdk.AccAddress([]byte("submitter"))
+	bundle := `{"moduleFormat":"endoZipBase64","endoZipBase64":"UEsDBA..."}`
+
+	compressor := types.NewMsgInstallBundle(bundle, submitter)
+	if err := compressor.Compress(); err != nil {
+		t.Fatal(err)
+	}
+	compressed := compressor.CompressedBundle
+	hash := types.BundleHashOf(bundle)
+
+	begin := types.NewMsgBeginBundleUpload(submitter, hash, int64(len(compressed)), int64(len(bundle)))
+	if err := k.BeginBundleUpload(ctx, begin); err != nil {
+		t.Fatal(err)
+	}
+
+	half := len(compressed) / 2
+	if err := k.UploadBundleChunk(ctx, types.NewMsgUploadBundleChunk(submitter, hash, 1, compressed[half:])); err == nil {
+		t.Errorf("wanted error for out of order chunk")
+	}
+	if err := k.UploadBundleChunk(ctx, types.NewMsgUploadBundleChunk(submitter, hash, 0, compressed[:half])); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.FinishBundleUpload(ctx, types.NewMsgFinishBundleUpload(submitter, hash)); err == nil {
+		t.Errorf("wanted error for incomplete upload")
+	}
+	if err := k.UploadBundleChunk(ctx, types.NewMsgUploadBundleChunk(submitter, hash, 1, compressed[half:])); err != nil {
+		t.Fatal(err)
+	}
+
+	installMsg, err := k.FinishBundleUpload(ctx, types.NewMsgFinishBundleUpload(submitter, hash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if installMsg.Bundle != bundle {
+		t.Errorf("got bundle %q, want %q", installMsg.Bundle, bundle)
+	}
+	if _, found := k.GetPendingBundleUpload(ctx, submitter, hash); found {
+		t.Errorf("upload still pending after finish")
+	}
+
+	// An upload whose hash doesn't match is rejected.
+	badHash := types.BundleHashOf("other")
+	begin = types.NewMsgBeginBundleUpload(submitter, badHash, int64(len(compressed)), int64(len(bundle)))
+	if err := k.BeginBundleUpload(ctx, begin); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.UploadBundleChunk(ctx, types.NewMsgUploadBundleChunk(submitter, badHash, 0, compressed)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.FinishBundleUpload(ctx, types.NewMsgFinishBundleUpload(submitter, badHash)); err == nil {
+		t.Errorf("wanted error for hash mismatch")
+	}
+
+	// Expired uploads are pruned.
+	if err := k.BeginBundleUpload(ctx, begin); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.UploadBundleChunk(ctx, types.NewMsgUploadBundleChunk(submitter, badHash, 0, compressed)); err != nil {
+		t.Fatal(err)
+	}
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + BundleUploadExpiryBlocks + 1)
+	if _, found := k.GetPendingBundleUpload(ctx, submitter, badHash); found {
+		t.Errorf("upload still pending after expiry")
+	}
+	k.PruneExpiredBundleUploads(ctx)
+	iter := ctx.KVStore(swingsetStoreKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		t.Errorf("unexpected key %q after pruning", iter.Key())
+	}
+}
+
+type testAction struct {
+	*vm.ActionHeader `actionType:"TEST"`
+}
+
+func TestInboundQueueSenders(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	alice := sdk.AccAddress([]byte("alice"))
+	bob := sdk.AccAddress([]byte("bob"))
+	const typeA = "/test.MsgA"
+	const typeB = "/test.MsgB"
+
+	for _, push := range []struct {
+		sender  sdk.AccAddress
+		msgType string
+	}{
+		{alice, typeA},
+		{bob, typeA},
+		{alice, typeB},
+		{alice, typeA},
+	} {
+		if err := k.PushActionFromSender(ctx, push.sender, push.msgType, testAction{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	checkCounts := func(when string, want map[string]int32) {
+		t.Helper()
+		for _, c := range []struct {
+			name    string
+			sender  sdk.AccAddress
+			msgType string
+		}{
+			{"alice", alice, ""},
+			{"alice A", alice, typeA},
+			{"alice B", alice, typeB},
+			{"bob", bob, ""},
+			{"bob A", bob, typeA},
+		} {
+			got, err := k.InboundQueueSenderCount(ctx, c.sender, c.msgType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want[c.name] {
+				t.Errorf("%s: got %s count %d, want %d", when, c.name, got, want[c.name])
+			}
+		}
+	}
+	checkCounts("after push", map[string]int32{"alice": 3, "alice A": 2, "alice B": 1, "bob": 1, "bob A": 1})
+
+	// Simulate the controller consuming the first three actions.
+	k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(StoragePathActionQueue+".head", "3"))
+	if err := k.PruneInboundSenders(ctx); err != nil {
+		t.Fatal(err)
+	}
+	checkCounts("after prune", map[string]int32{"alice": 1, "alice A": 1})
+
+	// Simulate the controller draining the queue, which removes its head and
+	// tail.
+	k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntryWithNoValue(StoragePathActionQueue+".head"))
+	k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntryWithNoValue(StoragePathActionQueue+".tail"))
+	if err := k.PruneInboundSenders(ctx); err != nil {
+		t.Fatal(err)
+	}
+	checkCounts("after drain", map[string]int32{})
+
+	// Queue indices restart from 0, so an index entry left over from before
+	// the drain is replaced rather than counted twice.
+	if err := k.PushActionFromSender(ctx, bob, typeA, testAction{}); err != nil {
+		t.Fatal(err)
+	}
+	k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntryWithNoValue(StoragePathActionQueue+".tail"))
+	if err := k.PushActionFromSender(ctx, alice, typeA, testAction{}); err != nil {
+		t.Fatal(err)
+	}
+	checkCounts("after reused index", map[string]int32{"alice": 1, "alice A": 1})
+
+	// The index is exported and imported with the genesis state.
+	if err := k.PushActionFromSender(ctx, bob, typeA, testAction{}); err != nil {
+		t.Fatal(err)
+	}
+	exported := k.ExportInboundQueueSenders(ctx)
+	want := []types.InboundQueueSender{
+		{Index: 0, Sender: alice.String(), MsgType: typeA},
+		{Index: 1, Sender: bob.String(), MsgType: typeA},
+	}
+	if !reflect.DeepEqual(exported, want) {
+		t.Errorf("got exported senders %v, want %v", exported, want)
+	}
+	k, ctx = makeTestKeeper(10)
+	if err := k.ImportInboundQueueSenders(ctx, exported); err != nil {
+		t.Fatal(err)
+	}
+	checkCounts("after import", map[string]int32{"alice": 1, "alice A": 1, "bob": 1, "bob A": 1})
+}
+
+type testOtherAction struct {
+	*vm.ActionHeader `actionType:"TEST_OTHER"`
+}
+
+func TestPurgeInboundQueue(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	alice := sdk.AccAddress([]byte("alice"))
+	bob := sdk.AccAddress([]byte("bob"))
+	const msgType = "/test.MsgA"
+
+	for _, push := range []struct {
+		sender sdk.AccAddress
+		action vm.Action
+	}{
+		{alice, testAction{}},
+		{bob, testOtherAction{}},
+		{alice, testOtherAction{}},
+		{bob, testAction{}},
+		{alice, testAction{}},
+	} {
+		if err := k.PushActionFromSender(ctx, push.sender, msgType, push.action); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	checkQueue := func(when string, wantHead int64, wantTypes []string) {
+		t.Helper()
+		head, err := k.vstorageKeeper.GetIntValue(ctx, StoragePathActionQueue+".head")
+		if err != nil {
+			t.Fatal(err)
+		}
+		tail, err := k.vstorageKeeper.GetIntValue(ctx, StoragePathActionQueue+".tail")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if head.Int64() != wantHead || tail.Int64() != 5 {
+			t.Errorf("%s: got head %s and tail %s, want %d and 5", when, head, tail, wantHead)
+		}
+		var gotTypes []string
+		for i := head.Int64(); i < tail.Int64(); i++ {
+			var record queuedRecord
+			value := k.vstorageKeeper.GetEntry(ctx, fmt.Sprintf("%s.%d", StoragePathActionQueue, i)).StringValue()
+			if err := json.Unmarshal([]byte(value), &record); err != nil {
+				t.Fatal(err)
+			}
+			gotTypes = append(gotTypes, record.Action.Type)
+		}
+		if !reflect.DeepEqual(gotTypes, wantTypes) {
+			t.Errorf("%s: got queue %q, want %q", when, gotTypes, wantTypes)
+		}
+		for i := int64(0); i < head.Int64(); i++ {
+			if k.vstorageKeeper.HasStorage(ctx, fmt.Sprintf("%s.%d", StoragePathActionQueue, i)) {
+				t.Errorf("%s: queue entry %d not removed", when, i)
+			}
+		}
+	}
+	checkCount := func(when string, sender sdk.AccAddress, want int32) {
+		t.Helper()
+		got, err := k.InboundQueueSenderCount(ctx, sender, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("%s: got count %d for %s, want %d", when, got, sender, want)
+		}
+	}
+
+	purge := func(criteria types.InboundQueuePurgeCriteria, want uint64) {
+		t.Helper()
+		msg := types.NewMsgPurgeInboundQueue("", "", criteria)
+		got, err := k.PurgeInboundQueue(ctx, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("purged %d records for %+v, want %d", got, criteria, want)
+		}
+	}
+
+	purge(types.InboundQueuePurgeCriteria{Sender: bob.String()}, 2)
+	checkQueue("purge by sender", 2, []string{"TEST", "TEST_OTHER", "TEST"})
+	checkCount("purge by sender", alice, 3)
+	checkCount("purge by sender", bob, 0)
+
+	// The sender index follows the moved records.
+	purge(types.InboundQueuePurgeCriteria{Sender: alice.String(), ActionType: "TEST_OTHER"}, 1)
+	checkQueue("purge by type", 3, []string{"TEST", "TEST"})
+	checkCount("purge by type", alice, 2)
+
+	purge(types.InboundQueuePurgeCriteria{StartIndex: 4}, 1)
+	checkQueue("purge by index", 4, []string{"TEST"})
+	checkCount("purge by index", alice, 1)
+
+	purge(types.InboundQueuePurgeCriteria{TxHash: "no such tx"}, 0)
+	checkQueue("purge nothing", 4, []string{"TEST"})
+
+	archived, err := k.vstorageKeeper.GetQueueLength(ctx, StoragePathPurgedActions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if archived.Int64() != 4 {
+		t.Errorf("got %s archived records, want 4", archived)
+	}
+	notified, err := k.vstorageKeeper.GetQueueLength(ctx, StoragePathHighPriorityQueue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if notified.Int64() != 3 {
+		t.Errorf("got %s INBOUND_QUEUE_PURGED actions, want 3", notified)
+	}
+}
+
+type mockBankKeeper struct {
+	bankkeeper.Keeper
+	sent     map[string]sdk.Coins
+	balances map[string]sdk.Coins
+}
+
+func (mbk mockBankKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return mbk.balances[addr.String()]
+}
+
+func (mbk mockBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	mbk.sent[senderAddr.String()] = mbk.sent[senderAddr.String()].Add(amt...)
+	return nil
+}
+
+type mockFeegrantKeeper struct {
+	allowances map[string]sdk.Coins
+}
+
+func (mfk mockFeegrantKeeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	key := granter.String() + "/" + grantee.String()
+	remaining, isNeg := mfk.allowances[key].SafeSub(fee...)
+	if isNeg {
+		return fmt.Errorf("fee limit exceeded")
+	}
+	mfk.allowances[key] = remaining
+	return nil
+}
+
+func TestChargeBeansFeeGrant(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	grantee := sdk.AccAddress([]byte("grantee"))
+	granter := sdk.AccAddress([]byte("granter"))
+	bank := mockBankKeeper{sent: map[string]sdk.Coins{}}
+	feegrant := mockFeegrantKeeper{allowances: map[string]sdk.Coins{
+		granter.String() + "/" + grantee.String(): cns(sdk.NewInt64Coin("uist", 1_500_000)),
+	}}
+	k.bankKeeper = bank
+	k.feegrantKeeper = feegrant
+	oneFeeUnit := types.DefaultBeansPerFeeUnit
+	uist := mkcoin("uist")
+
+	if err := k.ChargeBeans(ctx, grantee, oneFeeUnit); err != nil {
+		t.Fatal(err)
+	}
+	if !bank.sent[grantee.String()].IsEqual(cns(uist(1_000_000))) {
+		t.Errorf("got %s sent by grantee without grant, want 1000000uist", bank.sent[grantee.String()])
+	}
+
+	grantCtx := vm.WithFeeGrant(ctx, granter, nil)
+	if err := k.ChargeBeans(grantCtx, grantee, oneFeeUnit); err != nil {
+		t.Fatal(err)
+	}
+	if !bank.sent[granter.String()].IsEqual(cns(uist(1_000_000))) {
+		t.Errorf("got %s sent by granter, want 1000000uist", bank.sent[granter.String()])
+	}
+	if !bank.sent[grantee.String()].IsEqual(cns(uist(1_000_000))) {
+		t.Errorf("got %s sent by grantee with grant, want 1000000uist", bank.sent[grantee.String()])
+	}
+	var found bool
+	for _, event := range grantCtx.EventManager().Events() {
+		if event.Type != types.EventTypeGrantedBeanCharge {
+			continue
+		}
+		found = true
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		if attrs[types.AttributeKeyGranter] != granter.String() || attrs[types.AttributeKeyGrantee] != grantee.String() {
+			t.Errorf("got event attributes %v, want granter %s and grantee %s", attrs, granter, grantee)
+		}
+	}
+	if !found {
+		t.Errorf("no %s event", types.EventTypeGrantedBeanCharge)
+	}
+
+	// The allowance has only 500000uist left.
+	if err := k.ChargeBeans(grantCtx, grantee, oneFeeUnit); err == nil {
+		t.Errorf("want error when exceeding the allowance, got none")
+	}
+
+	// A grant from oneself is just a debit.
+	selfCtx := vm.WithFeeGrant(ctx, grantee, nil)
+	if err := k.ChargeBeans(selfCtx, grantee, oneFeeUnit); err != nil {
+		t.Fatal(err)
+	}
+	if !bank.sent[grantee.String()].IsEqual(cns(uist(2_000_000))) {
+		t.Errorf("got %s sent by grantee granting itself, want 2000000uist", bank.sent[grantee.String()])
+	}
+}
+
+func TestChargeBeansFeeDenom(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	addr := sdk.AccAddress([]byte("payer"))
+	bank := mockBankKeeper{sent: map[string]sdk.Coins{}}
+	k.bankKeeper = bank
+	params := types.DefaultParams()
+	params.FeeUnitPrice = cns(sdk.NewInt64Coin("ubld", 2_000_000), sdk.NewInt64Coin("ibc/X-Y", 1_000_000))
+	k.SetParams(ctx, params)
+	oneFeeUnit := types.DefaultBeansPerFeeUnit
+
+	// The default denom is the alphabetically first one.
+	if got := params.DefaultFeeDenom(); got != "ibc/X-Y" {
+		t.Errorf("got default fee denom %s, want ibc/X-Y", got)
+	}
+	if err := k.ChargeBeans(ctx, addr, oneFeeUnit); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.ChargeBeans(vm.WithFeeDenom(ctx, "ubld"), addr, oneFeeUnit); err != nil {
+		t.Fatal(err)
+	}
+	// A denom which is not accepted falls back to the default one.
+	if err := k.ChargeBeans(vm.WithFeeDenom(ctx, "uatom"), addr, oneFeeUnit); err != nil {
+		t.Fatal(err)
+	}
+	want := cns(sdk.NewInt64Coin("ubld", 2_000_000), sdk.NewInt64Coin("ibc/X-Y", 2_000_000))
+	if !bank.sent[addr.String()].IsEqual(want) {
+		t.Errorf("got %s sent, want %s", bank.sent[addr.String()], want)
+	}
+
+	// Beans owing are kept per denom, with uist at the historical path.
+	inboundTx := types.DefaultBeansPerInboundTx
+	if err := k.ChargeBeans(vm.WithFeeDenom(ctx, "ubld"), addr, inboundTx); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.ChargeBeans(ctx, addr, inboundTx.MulUint64(2)); err != nil {
+		t.Fatal(err)
+	}
+	if got := k.GetBeansOwing(ctx, addr, "ubld"); !got.Equal(inboundTx) {
+		t.Errorf("got %s beans owing in ubld, want %s", got, inboundTx)
+	}
+	if got := k.GetBeansOwing(ctx, addr, "ibc/X-Y"); !got.Equal(inboundTx.MulUint64(2)) {
+		t.Errorf("got %s beans owing in ibc/X-Y, want %s", got, inboundTx.MulUint64(2))
+	}
+	owingPath := StoragePathBeansOwing + "." + addr.String()
+	if got := k.vstorageKeeper.GetEntry(ctx, owingPath+".ibc-2fX-2dY").StringValue(); got != inboundTx.MulUint64(2).String() {
+		t.Errorf("got %s beans owing at %s.ibc-2fX-2dY, want %s", got, owingPath, inboundTx.MulUint64(2))
+	}
+	if got := k.vstorageKeeper.GetEntry(ctx, owingPath+".ubld").StringValue(); got != inboundTx.String() {
+		t.Errorf("got %s beans owing at %s.ubld, want %s", got, owingPath, inboundTx)
+	}
+	if got := denomPathSegment("ibc/X-Y_z"); got != "ibc-2fX-2dY_z" {
+		t.Errorf("got denom path segment %s, want ibc-2fX-2dY_z", got)
+	}
+
+	costs := k.EstimateBeansCosts(ctx, inboundTx)
+	wantCosts := cns(sdk.NewInt64Coin("ubld", 20_000), sdk.NewInt64Coin("ibc/X-Y", 10_000))
+	if !costs.IsEqual(wantCosts) {
+		t.Errorf("got costs %s, want %s", costs, wantCosts)
+	}
+}
+
+func TestChargeBeansLegacyDenom(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	addr := sdk.AccAddress([]byte("payer"))
+	k.bankKeeper = mockBankKeeper{sent: map[string]sdk.Coins{}}
+	inboundTx := types.DefaultBeansPerInboundTx
+	if err := k.ChargeBeans(ctx, addr, inboundTx); err != nil {
+		t.Fatal(err)
+	}
+	owingPath := StoragePathBeansOwing + "." + addr.String()
+	if got := k.vstorageKeeper.GetEntry(ctx, owingPath).StringValue(); got != inboundTx.String() {
+		t.Errorf("got %s beans owing at %s, want %s", got, owingPath, inboundTx)
+	}
+
+	// Adding a denom which sorts before uist does not move the uist debts.
+	params := k.GetParams(ctx)
+	params.FeeUnitPrice = params.FeeUnitPrice.Add(sdk.NewInt64Coin("ibc/A", 1_000_000))
+	k.SetParams(ctx, params)
+	if got := params.DefaultFeeDenom(); got != "ibc/A" {
+		t.Fatalf("got default fee denom %s, want ibc/A", got)
+	}
+	if got := k.GetBeansOwing(ctx, addr, "uist"); !got.Equal(inboundTx) {
+		t.Errorf("got %s beans owing in uist, want %s", got, inboundTx)
+	}
+	if got := k.GetBeansOwing(ctx, addr, "ibc/A"); !got.IsZero() {
+		t.Errorf("got %s beans owing in ibc/A, want 0", got)
+	}
+	if err := k.ChargeBeans(vm.WithFeeDenom(ctx, "uist"), addr, inboundTx); err != nil {
+		t.Fatal(err)
+	}
+	if got := k.vstorageKeeper.GetEntry(ctx, owingPath).StringValue(); got != inboundTx.MulUint64(2).String() {
+		t.Errorf("got %s beans owing at %s, want %s", got, owingPath, inboundTx.MulUint64(2))
+	}
+	if err := k.ChargeBeans(ctx, addr, inboundTx); err != nil {
+		t.Fatal(err)
+	}
+	if got := k.vstorageKeeper.GetEntry(ctx, owingPath+".ibc-2fA").StringValue(); got != inboundTx.String() {
+		t.Errorf("got %s beans owing at %s.ibc-2fA, want %s", got, owingPath, inboundTx)
+	}
+}
+
+func TestChargeBeansWithoutFeeUnitPrice(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	addr := sdk.AccAddress([]byte("payer"))
+	bank := mockBankKeeper{sent: map[string]sdk.Coins{}}
+	k.bankKeeper = bank
+	params := types.DefaultParams()
+	params.FeeUnitPrice = sdk.NewCoins()
+	k.SetParams(ctx, params)
+
+	// The beans are free, but still recorded as owed.
+	minFeeDebit := types.DefaultBeansPerMinFeeDebit
+	if err := k.ChargeBeans(ctx, addr, minFeeDebit); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.ChargeBeans(vm.WithFeeDenom(ctx, "ubld"), addr, minFeeDebit); err != nil {
+		t.Fatal(err)
+	}
+	if len(bank.sent) != 0 {
+		t.Errorf("got %v sent, want nothing", bank.sent)
+	}
+	want := minFeeDebit.MulUint64(2)
+	if got := k.GetBeansOwing(ctx, addr, ""); !got.Equal(want) {
+		t.Errorf("got %s beans owing, want %s", got, want)
+	}
+	owingPath := StoragePathBeansOwing + "." + addr.String()
+	if got := k.vstorageKeeper.GetEntry(ctx, owingPath).StringValue(); got != want.String() {
+		t.Errorf("got %s beans owing at %s, want %s", got, owingPath, want)
+	}
+}
+
+type mockAccountKeeper struct {
+	accounts map[string]authtypes.AccountI
+}
+
+func (mak mockAccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return mak.accounts[addr.String()]
+}
+
+func (mak mockAccountKeeper) NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+func (mak mockAccountKeeper) SetAccount(ctx sdk.Context, acc authtypes.AccountI) {
+	mak.accounts[acc.GetAddress().String()] = acc
+}
+
+func TestEgressLifecycle(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	owner := sdk.AccAddress([]byte("owner"))
+	other := sdk.AccAddress([]byte("other"))
+	passHolder := sdk.AccAddress([]byte("passHolder"))
+	bank := mockBankKeeper{
+		sent: map[string]sdk.Coins{},
+		balances: map[string]sdk.Coins{
+			passHolder.String(): privilegedProvisioningCoins,
+		},
+	}
+	k.bankKeeper = bank
+	k.accountKeeper = mockAccountKeeper{accounts: map[string]authtypes.AccountI{}}
+	gov := authtypes.NewModuleAddress("gov")
+	k.authority = gov.String()
+	params := types.DefaultParams()
+	params.PowerFlagFees = append(params.PowerFlagFees, types.NewPowerFlagFee("REMOTE_WALLET", cns(sdk.NewInt64Coin("ubld", 5))))
+	k.SetParams(ctx, params)
+
+	if _, err := k.UpdateEgressPowerFlags(ctx, owner, owner, []string{types.PowerFlagSmartWallet}, nil); err == nil {
+		t.Errorf("want error updating an unprovisioned egress, got none")
+	}
+	if err := k.SetEgress(ctx, types.NewEgress("owner", owner, []string{"REMOTE_WALLET"})); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := k.UpdateEgressPowerFlags(ctx, other, owner, nil, []string{"REMOTE_WALLET"}); err == nil {
+		t.Errorf("want error updating the egress of another address, got none")
+	}
+	if _, err := k.UpdateEgressPowerFlags(ctx, owner, owner, []string{"UNKNOWN"}, nil); err == nil {
+		t.Errorf("want error adding a power flag without a fee, got none")
+	}
+
+	powerFlags, err := k.UpdateEgressPowerFlags(ctx, owner, owner, []string{"REMOTE_WALLET", types.PowerFlagSmartWallet}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(powerFlags, []string{"REMOTE_WALLET", types.PowerFlagSmartWallet}) {
+		t.Errorf("got power flags %v", powerFlags)
+	}
+	// Only the newly added power flag is charged.
+	if !bank.sent[owner.String()].IsEqual(cns(sdk.NewInt64Coin("ubld", 10_000_000))) {
+		t.Errorf("got %s sent by owner, want 10000000ubld", bank.sent[owner.String()])
+	}
+
+	// A provisionpass doesn't allow managing the egress of another address.
+	if _, err := k.UpdateEgressPowerFlags(ctx, passHolder, owner, nil, []string{"REMOTE_WALLET"}); err == nil {
+		t.Errorf("want error updating the egress of another address with a provisionpass, got none")
+	}
+	if _, err := k.UpdateEgressPowerFlags(ctx, gov, owner, []string{"UNKNOWN"}, nil); err == nil {
+		t.Errorf("want error adding a power flag without a fee as the authority, got none")
+	}
+	powerFlags, err = k.UpdateEgressPowerFlags(ctx, gov, owner, nil, []string{"REMOTE_WALLET"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(powerFlags, []string{types.PowerFlagSmartWallet}) {
+		t.Errorf("got power flags %v", powerFlags)
+	}
+	if got := k.GetEgress(ctx, owner).PowerFlags; !reflect.DeepEqual(got, powerFlags) {
+		t.Errorf("got egress power flags %v, want %v", got, powerFlags)
+	}
+
+	// A provisionpass waives the fees for the egress of its holder.
+	if err := k.SetEgress(ctx, types.NewEgress("passHolder", passHolder, nil)); err != nil {
+		t.Fatal(err)
+	}
+	powerFlags, err = k.UpdateEgressPowerFlags(ctx, passHolder, passHolder, []string{"REMOTE_WALLET"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(powerFlags, []string{"REMOTE_WALLET"}) {
+		t.Errorf("got power flags %v", powerFlags)
+	}
+	if !bank.sent[passHolder.String()].IsZero() {
+		t.Errorf("got %s sent by provisionpass holder, want none", bank.sent[passHolder.String()])
+	}
+
+	if err := k.Deprovision(ctx, other, owner); err == nil {
+		t.Errorf("want error deprovisioning another address, got none")
+	}
+	if err := k.Deprovision(ctx, passHolder, owner); err == nil {
+		t.Errorf("want error deprovisioning another address with a provisionpass, got none")
+	}
+	if err := k.Deprovision(ctx, gov, owner); err != nil {
+		t.Fatal(err)
+	}
+	if !k.GetEgress(ctx, owner).Peer.Empty() {
+		t.Errorf("egress remains after deprovisioning")
+	}
+	if err := k.Deprovision(ctx, owner, owner); err == nil {
+		t.Errorf("want error deprovisioning twice, got none")
+	}
+}
+
+func TestEgressesAndMailboxesQueries(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	k.accountKeeper = mockAccountKeeper{accounts: map[string]authtypes.AccountI{}}
+	querier := Querier{k}
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	peers := []sdk.AccAddress{
+		sdk.AccAddress([]byte("peer0")),
+		sdk.AccAddress([]byte("peer1")),
+		sdk.AccAddress([]byte("peer2")),
+	}
+	for i, peer := range peers {
+		powerFlags := []string{}
+		if i != 1 {
+			powerFlags = append(powerFlags, types.PowerFlagSmartWallet)
+		}
+		if err := k.SetEgress(ctx, types.NewEgress(fmt.Sprintf("peer%d", i), peer, powerFlags)); err != nil {
+			t.Fatal(err)
+		}
+		k.SetMailbox(ctx, peer.String(), fmt.Sprintf(`{"ack":%d}`, i))
+	}
+
+	res, err := querier.Egresses(goCtx, &types.QueryEgressesRequest{Pagination: &query.PageRequest{Limit: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Egresses) != 2 || len(res.Pagination.NextKey) == 0 {
+		t.Fatalf("got %d egresses and next key %q, want 2 and a next key", len(res.Egresses), res.Pagination.NextKey)
+	}
+	res, err = querier.Egresses(goCtx, &types.QueryEgressesRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Egresses) != 1 || len(res.Pagination.NextKey) != 0 {
+		t.Fatalf("got %d egresses and next key %q, want 1 and no next key", len(res.Egresses), res.Pagination.NextKey)
+	}
+
+	res, err = querier.Egresses(goCtx, &types.QueryEgressesRequest{PowerFlag: types.PowerFlagSmartWallet})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var nicknames []string
+	for _, egress := range res.Egresses {
+		nicknames = append(nicknames, egress.Nickname)
+	}
+	sort.Strings(nicknames)
+	if !reflect.DeepEqual(nicknames, []string{"peer0", "peer2"}) {
+		t.Errorf("got egresses with power flag %v, want peer0 and peer2", nicknames)
+	}
+
+	mailboxes, err := querier.Mailboxes(goCtx, &types.QueryMailboxesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mailboxes.Mailboxes) != 3 {
+		t.Fatalf("got %d mailboxes, want 3", len(mailboxes.Mailboxes))
+	}
+	for _, mailbox := range mailboxes.Mailboxes {
+		if want := k.GetMailbox(ctx, mailbox.Peer); mailbox.Value != want {
+			t.Errorf("got mailbox %q for %s, want %q", mailbox.Value, mailbox.Peer, want)
+		}
+	}
+}
+
+func TestBlockStats(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	querier := Querier{k}
+
+	for _, out := range []string{"", "null", "undefined"} {
+		if _, found, err := k.RecordEndBlockReply(ctx, out); err != nil || found {
+			t.Errorf("got found %v and error %v for reply %q, want neither", found, err, out)
+		}
+	}
+	if _, _, err := k.RecordEndBlockReply(ctx, `{"computrons":12}`); err == nil {
+		t.Errorf("wanted error for non-string computrons")
+	}
+
+	reply := `{"computrons":"1000","beansUsed":"5000","cranks":3,"inboundProcessed":2,"stoppedEarly":true}`
+	stats, found, err := k.RecordEndBlockReply(ctx, reply)
+	if err != nil || !found {
+		t.Fatalf("got found %v and error %v, want stats", found, err)
+	}
+	want := types.BlockStats{
+		BlockHeight:      10,
+		Computrons:       sdkmath.NewUint(1000),
+		BeansUsed:        sdkmath.NewUint(5000),
+		Cranks:           3,
+		InboundProcessed: 2,
+		StoppedEarly:     true,
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("got stats %v, want %v", stats, want)
+	}
+
+	for height := int64(11); height <= 13; height++ {
+		k.RecordBlockStats(ctx, types.BlockStats{
+			BlockHeight: height,
+			Computrons:  sdkmath.NewUint(uint64(height)),
+			BeansUsed:   sdkmath.ZeroUint(),
+		})
+	}
+	res, err := querier.BlockStats(sdk.WrapSDKContext(ctx), &types.QueryBlockStatsRequest{MinHeight: 11, MaxHeight: 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Stats) != 2 || res.Stats[0].BlockHeight != 11 || res.Stats[1].BlockHeight != 12 {
+		t.Errorf("got stats %v, want heights 11 and 12", res.Stats)
+	}
+	if _, err := querier.BlockStats(sdk.WrapSDKContext(ctx), &types.QueryBlockStatsRequest{MinHeight: 12, MaxHeight: 11}); err == nil {
+		t.Errorf("wanted error for inverted height range")
+	}
+
+	// The stats are paginated.
+	res, err = querier.BlockStats(sdk.WrapSDKContext(ctx), &types.QueryBlockStatsRequest{
+		MinHeight:  11,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Stats) != 2 || res.Stats[0].BlockHeight != 11 || res.Stats[1].BlockHeight != 12 || res.Pagination.NextKey == nil {
+		t.Fatalf("got stats %v and pagination %v, want heights 11 and 12 and a next key", res.Stats, res.Pagination)
+	}
+	res, err = querier.BlockStats(sdk.WrapSDKContext(ctx), &types.QueryBlockStatsRequest{
+		MinHeight:  11,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Stats) != 1 || res.Stats[0].BlockHeight != 13 || res.Pagination.NextKey != nil {
+		t.Errorf("got stats %v and pagination %v, want height 13 and no next key", res.Stats, res.Pagination)
+	}
+
+	// Stats older than the retention window are pruned.
+	k.RecordBlockStats(ctx, types.BlockStats{
+		BlockHeight: 12 + BlockStatsRetentionBlocks,
+		Computrons:  sdkmath.ZeroUint(),
+		BeansUsed:   sdkmath.ZeroUint(),
+	})
+	var heights []int64
+	for _, stats := range k.GetBlockStats(ctx, 0, 0) {
+		heights = append(heights, stats.BlockHeight)
+	}
+	if !reflect.DeepEqual(heights, []int64{12, 13, 12 + BlockStatsRetentionBlocks}) {
+		t.Errorf("got heights %v after pruning, want 12, 13 and %d", heights, 12+BlockStatsRetentionBlocks)
+	}
+}
+
+func TestExportDataCheck(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	querier := Querier{k}
+
+	if k.StartExportDataCheck(10) {
+		t.Errorf("wanted no check due while disabled")
+	}
+	k.SetExportDataCheckInterval(5)
+	if k.StartExportDataCheck(11) {
+		t.Errorf("wanted no check due at height 11")
+	}
+	if !k.StartExportDataCheck(10) {
+		t.Errorf("wanted check due at height 10")
+	}
+	if k.StartExportDataCheck(15) {
+		t.Errorf("wanted no check while the previous one is running")
+	}
+	k.FinishExportDataCheck()
+	if !k.StartExportDataCheck(15) {
+		t.Errorf("wanted check due at height 15 after the previous one finished")
+	}
+	k.FinishExportDataCheck()
+
+	res, err := querier.ExportDataCheck(sdk.WrapSDKContext(ctx), &types.QueryExportDataCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Check != nil || res.Mismatches != 0 || res.Interval != 5 {
+		t.Errorf("got response %v before any check", res)
+	}
+
+	k.RecordExportDataCheck(types.ExportDataCheck{BlockHeight: 4, Hash: "sha256:aa", VmHash: "sha256:bb"})
+	k.RecordExportDataCheck(types.ExportDataCheck{BlockHeight: 9, Hash: "sha256:cc", VmHash: "sha256:cc", Consistent: true})
+	res, err = querier.ExportDataCheck(sdk.WrapSDKContext(ctx), &types.QueryExportDataCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Check == nil || res.Check.BlockHeight != 9 || !res.Check.Consistent || res.Mismatches != 1 {
+		t.Errorf("got response %v, want latest check at height 9 and 1 mismatch", res)
+	}
+
+	if _, err := querier.ExportDataCheck(sdk.WrapSDKContext(ctx), nil); err == nil {
+		t.Errorf("wanted error for empty request")
+	}
+}
+
+func TestCoreEvalOutcome(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	querier := Querier{k}
+	authority := authtypes.NewModuleAddress("gov").String()
+	eval := types.CoreEval{JsonPermits: "true", JsCode: "() => {}"}
+
+	// A proposal without core evals has no outcome.
+	k.Hooks().AfterProposalVotingPeriodEnded(ctx, 6)
+	if _, found := k.GetCoreEvalOutcome(ctx, 6); found {
+		t.Errorf("wanted no outcome for a proposal without core evals")
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := k.CoreEval(ctx, types.NewMsgCoreEval(authority, []types.CoreEval{eval}, nil)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	k.Hooks().AfterProposalVotingPeriodEnded(ctx, 7)
+	outcome, found := k.GetCoreEvalOutcome(ctx, 7)
+	if !found || outcome.Pending != 2 || outcome.Success || outcome.Error != "" {
+		t.Fatalf("got outcome %v, found %t, want 2 pending", outcome, found)
+	}
+
+	if err := k.RecordCoreEvalResult(ctx, 1, true, ""); err != nil {
+		t.Fatal(err)
+	}
+	if outcome, _ = k.GetCoreEvalOutcome(ctx, 7); outcome.Pending != 1 || outcome.Success {
+		t.Errorf("got outcome %v, want 1 pending", outcome)
+	}
+	if err := k.RecordCoreEvalResult(ctx, 2, false, "boom"); err != nil {
+		t.Fatal(err)
+	}
+	if outcome, _ = k.GetCoreEvalOutcome(ctx, 7); outcome.Pending != 0 || outcome.Success || outcome.Error != "boom" {
+		t.Errorf("got outcome %v, want failure", outcome)
+	}
+	if err := k.RecordCoreEvalResult(ctx, 2, true, ""); err == nil {
+		t.Errorf("wanted error recording a core eval twice")
+	}
+
+	if err := k.CoreEval(ctx, types.NewMsgCoreEval(authority, []types.CoreEval{eval}, nil)); err != nil {
+		t.Fatal(err)
+	}
+	k.Hooks().AfterProposalVotingPeriodEnded(ctx, 8)
+	if err := k.RecordCoreEvalResult(ctx, 3, true, ""); err != nil {
+		t.Fatal(err)
+	}
+	res, err := querier.CoreEvalOutcome(sdk.WrapSDKContext(ctx), &types.QueryCoreEvalOutcomeRequest{ProposalId: 8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Outcome.Pending != 0 || !res.Outcome.Success {
+		t.Errorf("got outcome %v, want success", res.Outcome)
+	}
+	if _, err := querier.CoreEvalOutcome(sdk.WrapSDKContext(ctx), &types.QueryCoreEvalOutcomeRequest{ProposalId: 6}); err == nil {
+		t.Errorf("wanted error for a proposal without outcome")
+	}
+}
+
+func TestBundleInstallOutcome(t *testing.T) {
+	k, ctx := makeTestKeeper(10)
+	submitter := sdk.AccAddress([]byte("submitter"))
+	msg := types.NewMsgInstallBundle(`{"moduleFormat":"endoZipBase64","endoZipBase64Sha512":"abc123","endoZipBase64":""}`, submitter)
+	const bundleID = "b1-abc123"
+
+	if err := k.RecordBundleInstallOutcome(ctx, bundleID, true, ""); err == nil {
+		t.Errorf("wanted error for an unknown bundle")
+	}
+
+	k.RecordBundleInstall(ctx, msg)
+	bundle, found := k.GetInstalledBundle(ctx, bundleID)
+	if !found || !bundle.Pending || bundle.Accepted {
+		t.Fatalf("got bundle %v, found %t, want pending", bundle, found)
+	}
+	if err := k.RecordBundleInstallOutcome(ctx, bundleID, false, "bad bundle"); err != nil {
+		t.Fatal(err)
+	}
+	if k.IsBundleAccepted(ctx, bundleID) {
+		t.Errorf("wanted rejected bundle")
+	}
+
+	// A rejected bundle may be installed again.
+	k.RecordBundleInstall(ctx.WithBlockHeight(11), msg)
+	if bundle, _ = k.GetInstalledBundle(ctx, bundleID); !bundle.Pending || bundle.BlockHeight != 11 {
+		t.Errorf("got bundle %v, want pending at height 11", bundle)
+	}
+
+	// A later bundle claiming the same ID while the first is pending does not
+	// replace it, and its rejection does not undo the acceptance of the first.
+	other := sdk.AccAddress([]byte("other"))
+	bogus := types.NewMsgInstallBundle(`{"moduleFormat":"endoZipBase64","endoZipBase64Sha512":"abc123","endoZipBase64":"bogus"}`, other)
+	k.RecordBundleInstall(ctx.WithBlockHeight(12), bogus)
+	if bundle, _ = k.GetInstalledBundle(ctx, bundleID); !bundle.Pending || bundle.BlockHeight != 11 || !bundle.Submitter.Equals(submitter) {
+		t.Errorf("got bundle %v, want pending at height 11 from %s", bundle, submitter)
+	}
+	if err := k.RecordBundleInstallOutcome(ctx, bundleID, true, ""); err != nil {
+		t.Fatal(err)
+	}
+	if !k.IsBundleAccepted(ctx, bundleID) {
+		t.Errorf("wanted accepted bundle")
+	}
+	if err := k.RecordBundleInstallOutcome(ctx, bundleID, false, "bundle hash mismatch"); err != nil {
+		t.Fatal(err)
+	}
+	if bundle, _ = k.GetInstalledBundle(ctx, bundleID); bundle.Pending || !bundle.Accepted || bundle.Error != "" {
+		t.Errorf("got bundle %v, want accepted without error", bundle)
+	}
+
+	// An accepted bundle stays accepted when installed again.
+	k.RecordBundleInstall(ctx.WithBlockHeight(12), msg)
+	if bundle, _ = k.GetInstalledBundle(ctx, bundleID); bundle.Pending || !bundle.Accepted || bundle.BlockHeight != 11 {
+		t.Errorf("got bundle %v, want accepted at height 11", bundle)
+	}
+}
@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 
+	sdkioerrors "cosmossdk.io/errors"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type msgServer struct {
@@ -36,7 +39,7 @@ func (keeper msgServer) routeAction(ctx sdk.Context, msg vm.ControllerAdmissionM
 	if isHighPriority {
 		return keeper.PushHighPriorityAction(ctx, action)
 	} else {
-		return keeper.PushAction(ctx, action)
+		return keeper.PushActionFromSender(ctx, msg.GetSigners()[0], sdk.MsgTypeURL(msg), action)
 	}
 }
 
@@ -116,6 +119,71 @@ func (keeper msgServer) WalletSpendAction(goCtx context.Context, msg *types.MsgW
 	return &types.MsgWalletSpendActionResponse{}, nil
 }
 
+// WalletOffer delivers a structured offer to the smart wallet as the
+// equivalent spend action.
+func (keeper msgServer) WalletOffer(goCtx context.Context, msg *types.MsgWalletOffer) (*types.MsgWalletOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	spendAction, err := msg.SpendAction()
+	if err != nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	err = keeper.provisionIfNeeded(ctx, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	action := walletSpendAction{
+		Owner:       msg.Owner.String(),
+		SpendAction: spendAction,
+	}
+	err = keeper.routeAction(ctx, msg, action)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWalletOfferResponse{}, nil
+}
+
+// walletBatchEntry is an action of a walletActionBatch, with the field of
+// either walletAction or walletSpendAction.
+type walletBatchEntry struct {
+	Action      string `json:"action,omitempty"`
+	SpendAction string `json:"spendAction,omitempty"`
+}
+
+type walletActionBatch struct {
+	*vm.ActionHeader `actionType:"WALLET_ACTION_BATCH"`
+	Owner            string             `json:"owner"`
+	Actions          []walletBatchEntry `json:"actions"`
+}
+
+func (keeper msgServer) WalletActionBatch(goCtx context.Context, msg *types.MsgWalletActionBatch) (*types.MsgWalletActionBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := keeper.provisionIfNeeded(ctx, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	action := walletActionBatch{
+		Owner:   msg.Owner.String(),
+		Actions: make([]walletBatchEntry, len(msg.Actions)),
+	}
+	for i, a := range msg.Actions {
+		if a.Spend {
+			action.Actions[i].SpendAction = a.Action
+		} else {
+			action.Actions[i].Action = a.Action
+		}
+	}
+	err = keeper.routeAction(ctx, msg, action)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWalletActionBatchResponse{}, nil
+}
+
 type provisionAction struct {
 	*vm.ActionHeader `actionType:"PLEASE_PROVISION"`
 	*types.MsgProvision
@@ -183,6 +251,70 @@ func (keeper msgServer) Provision(goCtx context.Context, msg *types.MsgProvision
 	return &types.MsgProvisionResponse{}, nil
 }
 
+// updatePowerFlagsAction reports the resulting power flags of an egress, as
+// well as those which it didn't have before, so that the controller can
+// provision what they grant.
+type updatePowerFlagsAction struct {
+	*vm.ActionHeader `actionType:"UPDATE_POWER_FLAGS"`
+	*types.MsgUpdateEgressPowerFlags
+	PowerFlags      []string `json:"powerFlags"`
+	AddedPowerFlags []string `json:"addedPowerFlags"`
+}
+
+func (keeper msgServer) UpdateEgressPowerFlags(goCtx context.Context, msg *types.MsgUpdateEgressPowerFlags) (*types.MsgUpdateEgressPowerFlagsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	had := map[string]bool{}
+	for _, powerFlag := range keeper.GetEgress(ctx, msg.Address).PowerFlags {
+		had[powerFlag] = true
+	}
+	powerFlags, err := keeper.Keeper.UpdateEgressPowerFlags(ctx, msg.Submitter, msg.Address, msg.AddPowerFlags, msg.RemovePowerFlags)
+	if err != nil {
+		return nil, err
+	}
+
+	action := updatePowerFlagsAction{
+		MsgUpdateEgressPowerFlags: msg,
+		PowerFlags:                powerFlags,
+		AddedPowerFlags:           []string{},
+	}
+	for _, powerFlag := range powerFlags {
+		if !had[powerFlag] {
+			action.AddedPowerFlags = append(action.AddedPowerFlags, powerFlag)
+		}
+	}
+	err = keeper.routeAction(ctx, msg, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEgressPowerFlagsResponse{PowerFlags: powerFlags}, nil
+}
+
+type deprovisionAction struct {
+	*vm.ActionHeader `actionType:"PLEASE_DEPROVISION"`
+	*types.MsgDeprovision
+}
+
+func (keeper msgServer) Deprovision(goCtx context.Context, msg *types.MsgDeprovision) (*types.MsgDeprovisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := keeper.Keeper.Deprovision(ctx, msg.Submitter, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	action := deprovisionAction{
+		MsgDeprovision: msg,
+	}
+	err = keeper.routeAction(ctx, msg, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeprovisionResponse{}, nil
+}
+
 type installBundleAction struct {
 	*vm.ActionHeader `actionType:"INSTALL_BUNDLE"`
 	*types.MsgInstallBundle
@@ -195,6 +327,7 @@ func (keeper msgServer) InstallBundle(goCtx context.Context, msg *types.MsgInsta
 	if err != nil {
 		return nil, err
 	}
+	keeper.RecordBundleInstall(ctx, msg)
 	action := installBundleAction{
 		MsgInstallBundle: msg,
 	}
@@ -207,3 +340,87 @@ func (keeper msgServer) InstallBundle(goCtx context.Context, msg *types.MsgInsta
 
 	return &types.MsgInstallBundleResponse{}, nil
 }
+
+func (keeper msgServer) CoreEval(goCtx context.Context, msg *types.MsgCoreEval) (*types.MsgCoreEvalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if keeper.GetAuthority() != msg.Authority {
+		return nil, sdkioerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", keeper.GetAuthority(), msg.Authority)
+	}
+
+	err := keeper.Keeper.CoreEval(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCoreEvalResponse{}, nil
+}
+
+func (keeper msgServer) BeginBundleUpload(goCtx context.Context, msg *types.MsgBeginBundleUpload) (*types.MsgBeginBundleUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := keeper.Keeper.BeginBundleUpload(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBeginBundleUploadResponse{}, nil
+}
+
+func (keeper msgServer) UploadBundleChunk(goCtx context.Context, msg *types.MsgUploadBundleChunk) (*types.MsgUploadBundleChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := keeper.Keeper.UploadBundleChunk(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUploadBundleChunkResponse{}, nil
+}
+
+func (keeper msgServer) FinishBundleUpload(goCtx context.Context, msg *types.MsgFinishBundleUpload) (*types.MsgFinishBundleUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	installMsg, err := keeper.Keeper.FinishBundleUpload(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	keeper.RecordBundleInstall(ctx, installMsg)
+	action := installBundleAction{
+		MsgInstallBundle: installMsg,
+	}
+
+	err = keeper.routeAction(ctx, msg, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFinishBundleUploadResponse{}, nil
+}
+
+func (keeper msgServer) SetInboundPause(goCtx context.Context, msg *types.MsgSetInboundPause) (*types.MsgSetInboundPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if keeper.GetAuthority() != msg.Authority {
+		return nil, sdkioerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", keeper.GetAuthority(), msg.Authority)
+	}
+
+	keeper.Keeper.SetInboundPause(ctx, msg.Pause)
+
+	return &types.MsgSetInboundPauseResponse{}, nil
+}
+
+func (keeper msgServer) PurgeInboundQueue(goCtx context.Context, msg *types.MsgPurgeInboundQueue) (*types.MsgPurgeInboundQueueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if keeper.GetAuthority() != msg.Authority {
+		return nil, sdkioerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", keeper.GetAuthority(), msg.Authority)
+	}
+
+	purged, err := keeper.Keeper.PurgeInboundQueue(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPurgeInboundQueueResponse{Purged: purged}, nil
+}
@@ -2,17 +2,23 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 )
 
 type coreEvalAction struct {
 	*vm.ActionHeader `actionType:"CORE_EVAL"`
 	Evals            []types.CoreEval `json:"evals"`
+	// CoreEvalID identifies a MsgCoreEval so that the VM can report its
+	// outcome. It is omitted for legacy CoreEvalProposals.
+	CoreEvalID uint64 `json:"coreEvalId,omitempty"`
 }
 
 // CoreEvalProposal tells SwingSet to evaluate the given JS code.
@@ -30,3 +36,146 @@ func (k Keeper) CoreEvalProposal(ctx sdk.Context, p *types.CoreEvalProposal) err
 	ctx = ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxMsgIdxContextKey, 0))
 	return k.PushHighPriorityAction(ctx, action)
 }
+
+// CoreEval installs the bundles attached to a governance MsgCoreEval, then
+// tells SwingSet to evaluate its JS code. Since governance executes proposal
+// messages in a cached context, either all of the actions are queued or none
+// of them are.
+func (k Keeper) CoreEval(ctx sdk.Context, msg *types.MsgCoreEval) error {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	coreEvalID := k.nextCoreEvalID(ctx)
+
+	// Like the CoreEvalProposal, the governance message has no transaction
+	// provenance by the time it executes, so we synthesize a unique txHash from
+	// the core eval ID and number each queued action.
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxHashContextKey, fmt.Sprintf("x/gov/%d", coreEvalID)))
+	msgIdx := 0
+	for i, bundle := range msg.Bundles {
+		installMsg := bundle.InstallBundleMsg(authority)
+		if err := installMsg.Uncompress(); err != nil {
+			return fmt.Errorf("bundle %d: %w", i, err)
+		}
+		k.RecordBundleInstall(ctx, installMsg)
+		action := installBundleAction{
+			MsgInstallBundle: installMsg,
+		}
+		ctx := ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxMsgIdxContextKey, msgIdx))
+		if err := k.PushHighPriorityAction(ctx, action); err != nil {
+			return err
+		}
+		msgIdx++
+	}
+
+	action := coreEvalAction{
+		Evals:      msg.Evals,
+		CoreEvalID: coreEvalID,
+	}
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxMsgIdxContextKey, msgIdx))
+	if err := k.PushHighPriorityAction(ctx, action); err != nil {
+		return err
+	}
+
+	k.getPendingCoreEvalStore(ctx).Set(sdk.Uint64ToBigEndian(coreEvalID), []byte{})
+	return nil
+}
+
+func (k Keeper) nextCoreEvalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	if bz := store.Get([]byte(coreEvalSeqKey)); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	id++
+	store.Set([]byte(coreEvalSeqKey), sdk.Uint64ToBigEndian(id))
+	return id
+}
+
+func (k Keeper) getPendingCoreEvalStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(pendingCoreEvalKeyPrefix))
+}
+
+func (k Keeper) getCoreEvalProposalStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(coreEvalProposalKeyPrefix))
+}
+
+func (k Keeper) getCoreEvalOutcomeStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(coreEvalOutcomeKeyPrefix))
+}
+
+// assignPendingCoreEvals associates the core evals queued since the last
+// assignment with the given governance proposal, and records a pending
+// outcome for the proposal if there were any.
+func (k Keeper) assignPendingCoreEvals(ctx sdk.Context, proposalID uint64) {
+	pendingStore := k.getPendingCoreEvalStore(ctx)
+	proposalStore := k.getCoreEvalProposalStore(ctx)
+
+	var coreEvalIDs [][]byte
+	iterator := pendingStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		coreEvalIDs = append(coreEvalIDs, iterator.Key())
+	}
+	iterator.Close()
+	if len(coreEvalIDs) == 0 {
+		return
+	}
+
+	for _, key := range coreEvalIDs {
+		pendingStore.Delete(key)
+		proposalStore.Set(key, sdk.Uint64ToBigEndian(proposalID))
+	}
+	k.SetCoreEvalOutcome(ctx, types.CoreEvalOutcome{
+		ProposalId: proposalID,
+		Pending:    uint32(len(coreEvalIDs)),
+	})
+}
+
+// GetCoreEvalOutcome returns the outcome of the core evals of a governance
+// proposal, and whether there is one.
+func (k Keeper) GetCoreEvalOutcome(ctx sdk.Context, proposalID uint64) (types.CoreEvalOutcome, bool) {
+	bz := k.getCoreEvalOutcomeStore(ctx).Get(sdk.Uint64ToBigEndian(proposalID))
+	if bz == nil {
+		return types.CoreEvalOutcome{}, false
+	}
+	outcome := types.CoreEvalOutcome{}
+	k.cdc.MustUnmarshal(bz, &outcome)
+	return outcome, true
+}
+
+// SetCoreEvalOutcome sets the outcome of the core evals of a governance proposal.
+func (k Keeper) SetCoreEvalOutcome(ctx sdk.Context, outcome types.CoreEvalOutcome) {
+	bz := k.cdc.MustMarshal(&outcome)
+	k.getCoreEvalOutcomeStore(ctx).Set(sdk.Uint64ToBigEndian(outcome.ProposalId), bz)
+}
+
+// RecordCoreEvalResult updates the outcome of the proposal which queued the
+// given core eval with the result reported by the VM.
+func (k Keeper) RecordCoreEvalResult(ctx sdk.Context, coreEvalID uint64, success bool, errMsg string) error {
+	proposalStore := k.getCoreEvalProposalStore(ctx)
+	key := sdk.Uint64ToBigEndian(coreEvalID)
+	bz := proposalStore.Get(key)
+	if bz == nil {
+		return fmt.Errorf("no pending core eval %d", coreEvalID)
+	}
+	proposalStore.Delete(key)
+
+	outcome, found := k.GetCoreEvalOutcome(ctx, binary.BigEndian.Uint64(bz))
+	if !found {
+		return fmt.Errorf("no outcome for core eval %d", coreEvalID)
+	}
+	if outcome.Pending > 0 {
+		outcome.Pending--
+	}
+	if !success && outcome.Error == "" {
+		outcome.Error = errMsg
+		if outcome.Error == "" {
+			outcome.Error = fmt.Sprintf("core eval %d failed", coreEvalID)
+		}
+	}
+	outcome.Success = outcome.Pending == 0 && outcome.Error == ""
+	k.SetCoreEvalOutcome(ctx, outcome)
+	return nil
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/klauspost/compress/zstd"
+)
+
+// The "export data" file of a swing-store export saved on disk is encoded as
+// JSON lines by default, which is the only format understood by the JS side.
+// Exports written for storage, like genesis exports, can instead use a more
+// compact format, optionally compressed, recorded in the export manifest.
+// Such exports are converted back to JSON lines by RestoreExport.
+
+const (
+	// ExportDataEncodingJsonl encodes each "export data" entry as a line of
+	// [key, value] JSON array.
+	ExportDataEncodingJsonl = "jsonl"
+	// ExportDataEncodingBinary encodes each "export data" entry as a
+	// length-prefixed binary record, see agoric.EncodeKVEntryReaderToBinary.
+	ExportDataEncodingBinary = "binary"
+
+	// ExportDataCompressionGzip compresses the encoded "export data" with gzip.
+	ExportDataCompressionGzip = "gzip"
+	// ExportDataCompressionZstd compresses the encoded "export data" with zstd.
+	ExportDataCompressionZstd = "zstd"
+
+	// exportDataFormatSeparator separates the encoding and compression of an
+	// "export data" format, like "binary+zstd".
+	exportDataFormatSeparator = "+"
+)
+
+// exportDataFormat is the encoding and optional compression of the "export
+// data" file of a swing-store export.
+type exportDataFormat struct {
+	encoding    string
+	compression string
+}
+
+// parseExportDataFormat parses an "export data" format of the form
+// "<encoding>" or "<encoding>+<compression>". The empty format is JSON lines.
+func parseExportDataFormat(format string) (exportDataFormat, error) {
+	if format == "" {
+		return exportDataFormat{encoding: ExportDataEncodingJsonl}, nil
+	}
+	encoding, compression, _ := strings.Cut(format, exportDataFormatSeparator)
+	switch encoding {
+	case ExportDataEncodingJsonl, ExportDataEncodingBinary:
+	default:
+		return exportDataFormat{}, fmt.Errorf("unknown export data encoding %q in format %q", encoding, format)
+	}
+	switch compression {
+	case "", ExportDataCompressionGzip, ExportDataCompressionZstd:
+	default:
+		return exportDataFormat{}, fmt.Errorf("unknown export data compression %q in format %q", compression, format)
+	}
+	return exportDataFormat{encoding: encoding, compression: compression}, nil
+}
+
+// ValidateExportDataFormat returns an error if the format is not a valid
+// "export data" format, like "jsonl", "binary" or "binary+zstd".
+func ValidateExportDataFormat(format string) error {
+	_, err := parseExportDataFormat(format)
+	return err
+}
+
+// manifestValue returns the value of the format in an export manifest, which
+// is empty for JSON lines as understood by the JS side.
+func (format exportDataFormat) manifestValue() string {
+	if format.compression == "" {
+		if format.encoding == ExportDataEncodingJsonl {
+			return ""
+		}
+		return format.encoding
+	}
+	return format.encoding + exportDataFormatSeparator + format.compression
+}
+
+// filename returns the name of an "export data" file of this format.
+func (format exportDataFormat) filename() string {
+	filename := exportDataFilename
+	if format.encoding == ExportDataEncodingBinary {
+		filename = binaryExportDataFilename
+	}
+	switch format.compression {
+	case ExportDataCompressionGzip:
+		filename += ".gz"
+	case ExportDataCompressionZstd:
+		filename += ".zst"
+	}
+	return filename
+}
+
+// encode consumes the reader and writes its entries in this format.
+// It will not Close the reader when done.
+func (format exportDataFormat) encode(reader agoric.KVEntryReader, writer io.Writer) (err error) {
+	var compressor io.WriteCloser
+	switch format.compression {
+	case ExportDataCompressionGzip:
+		compressor = gzip.NewWriter(writer)
+	case ExportDataCompressionZstd:
+		compressor, err = zstd.NewWriter(writer)
+		if err != nil {
+			return err
+		}
+	}
+	if compressor != nil {
+		writer = compressor
+		defer func() {
+			if closeErr := compressor.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+	}
+
+	if format.encoding == ExportDataEncodingBinary {
+		return agoric.EncodeKVEntryReaderToBinary(reader, writer)
+	}
+	return agoric.EncodeKVEntryReaderToJsonl(reader, writer)
+}
+
+// exportDataStream is the decompressed content of an "export data" file.
+// Once the content ends, the rest of the file is consumed, so that a
+// verifyingReader of the file checks its digest.
+type exportDataStream struct {
+	io.Reader
+	file         io.ReadCloser
+	decompressor io.Closer
+}
+
+// Read implements io.Reader.
+func (stream exportDataStream) Read(p []byte) (int, error) {
+	n, err := stream.Reader.Read(p)
+	if err == io.EOF {
+		if _, drainErr := io.Copy(io.Discard, stream.file); drainErr != nil {
+			return n, drainErr
+		}
+	}
+	return n, err
+}
+
+// Close implements io.Closer.
+func (stream exportDataStream) Close() error {
+	var err error
+	if stream.decompressor != nil {
+		err = stream.decompressor.Close()
+	}
+	if fileErr := stream.file.Close(); err == nil {
+		err = fileErr
+	}
+	return err
+}
+
+// newReader returns a KVEntryReader decoding an "export data" file of this
+// format. Closing the reader closes the file.
+func (format exportDataFormat) newReader(file io.ReadCloser) (agoric.KVEntryReader, error) {
+	stream := exportDataStream{Reader: file, file: file}
+	switch format.compression {
+	case ExportDataCompressionGzip:
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("cannot decompress export data: %w", err)
+		}
+		stream.Reader = gzipReader
+		stream.decompressor = gzipReader
+	case ExportDataCompressionZstd:
+		zstdReader, err := zstd.NewReader(file)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("cannot decompress export data: %w", err)
+		}
+		stream.Reader = zstdReader
+		stream.decompressor = zstdReader.IOReadCloser()
+	}
+
+	if format.encoding == ExportDataEncodingBinary {
+		return agoric.NewBinaryKVEntryDecoderReader(stream), nil
+	}
+	return agoric.NewJsonlKVEntryDecoderReader(stream), nil
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+func readTestExportData(t *testing.T, provider SwingStoreExportProvider) []string {
+	reader, err := provider.GetExportDataReader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	var entries []string
+	for {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			return entries
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry.Key()+"="+entry.StringValue())
+	}
+}
+
+func TestSwingStoreExportDataFormats(t *testing.T) {
+	for _, format := range []string{"", "jsonl+gzip", "binary", "binary+gzip", "binary+zstd"} {
+		exportDir := t.TempDir()
+		provider := newTestSwingStoreExportProvider(42)
+		provider.ExportDataFormat = format
+		if err := WriteSwingStoreExportToDirectory(provider, exportDir); err != nil {
+			t.Fatalf("format %q: %v", format, err)
+		}
+
+		rawManifest, err := os.ReadFile(filepath.Join(exportDir, ExportManifestFilename))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var manifest exportManifest
+		if err := json.Unmarshal(rawManifest, &manifest); err != nil {
+			t.Fatal(err)
+		}
+		if manifest.DataFormat != format {
+			t.Errorf("got manifest data format %q, want %q", manifest.DataFormat, format)
+		}
+
+		opened, err := OpenSwingStoreExportDirectory(exportDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries := readTestExportData(t, opened)
+		if len(entries) != 2 || entries[0] != "bundle.b1-1234=1234" || entries[1] != "transcript.v1.current={}" {
+			t.Errorf("format %q: got export data %v", format, entries)
+		}
+
+		// The digest of the file is verified whatever the format.
+		dataPath := filepath.Join(exportDir, manifest.Data)
+		data, err := os.ReadFile(dataPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(dataPath, append(data, 0), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := readTestSwingStoreExport(exportDir); err == nil {
+			t.Errorf("format %q: wanted error for changed export data", format)
+		}
+	}
+
+	if err := ValidateExportDataFormat("binary+lz4"); err == nil {
+		t.Error("wanted error for unknown compression")
+	}
+	if err := ValidateExportDataFormat("protobuf"); err == nil {
+		t.Error("wanted error for unknown encoding")
+	}
+}
+
+func TestSwingStoreRestoreExportDataFormat(t *testing.T) {
+	exportDir := t.TempDir()
+	provider := newTestSwingStoreExportProvider(42)
+	provider.ExportDataFormat = "binary+zstd"
+	if err := WriteSwingStoreExportToDirectory(provider, exportDir); err != nil {
+		t.Fatal(err)
+	}
+	opened, err := OpenSwingStoreExportDirectory(exportDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A provider carrying a format, like one retrieved for a genesis export.
+	opened.ExportDataFormat = "binary"
+
+	// The restored export is written in the format understood by JS.
+	exportsHandler := newTestSwingStoreExportsHandler()
+	var restoredData []byte
+	exportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+		restoreDir := action.(*swingStoreRestoreExportAction).Args[0].ExportDir
+		restoredData, err = os.ReadFile(filepath.Join(restoreDir, exportDataFilename))
+		return "", err
+	}
+	err = exportsHandler.RestoreExport(opened, SwingStoreRestoreOptions{
+		ArtifactMode:   SwingStoreArtifactModeOperational,
+		ExportDataMode: SwingStoreExportDataModeAll,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "[\"bundle.b1-1234\",\"1234\"]\n[\"transcript.v1.current\",\"{}\"]\n"
+	if string(restoredData) != expected {
+		t.Errorf("got restored export data %q, want %q", restoredData, expected)
+	}
+}
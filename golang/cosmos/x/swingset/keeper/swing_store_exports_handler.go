@@ -1,13 +1,17 @@
 package keeper
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sync"
 
 	sdkioerrors "cosmossdk.io/errors"
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
@@ -46,8 +50,10 @@ import (
 // SwingStoreExportEventHandler, and provides some synchronization methods to
 // let the application enforce mutation boundaries.
 //
-// There should be a single SwingStoreExportsHandler instance, and all its method
-// calls should be performed from the same goroutine (no mutex enforcement).
+// There should be a single SwingStoreExportsHandler instance per app, and all
+// its method calls should be performed from the same goroutine (no mutex
+// enforcement). The state of the active operation is held by the instance, so
+// that multiple apps may run in the same process.
 //
 // The process of generating a SwingStore export proceeds as follow:
 // - The component invokes swingStoreExportsHandler.InitiateExport with an
@@ -57,7 +63,7 @@ import (
 //   side to start generating an export of the swing-store, and calls the
 //   eventHandler's OnExportStarted method with a function param allowing it to
 //   retrieve the export.
-// - The cosmos app will call WaitUntilSwingStoreExportStarted before
+// - The cosmos app will call exportsHandler.WaitUntilSwingStoreExportStarted before
 //   instructing the JS controller to commit its work, satisfying the
 //   deterministic exports requirement.
 // - OnExportStarted must call the retrieve function before returning, however
@@ -84,13 +90,114 @@ import (
 // "export-manifest.json" in the export directory. It contains the file names
 // for the "export data" (described in the godoc for exportDataFilename), and
 // for the opaque artifacts of the export.
+// Manifests written by WriteSwingStoreExportToDirectory also contain the size
+// and SHA-256 hash of these files, which are verified when reading the export
+// from disk. The JS side ignores these digests.
+// A layered export, written relative to a base export, only contains the
+// artifacts that are new or changed since the base. The other artifacts have
+// an empty file name, and are read from the base export. Layered exports are
+// not understood by the JS side, and are flattened by RestoreExport.
 type exportManifest struct {
 	// BlockHeight is the block height of the manifest.
 	BlockHeight uint64 `json:"blockHeight,omitempty"`
 	// Data is the filename of the export data.
 	Data string `json:"data,omitempty"`
+	// DataFormat is the format of the export data file, as parsed by
+	// parseExportDataFormat. Empty for JSON lines.
+	DataFormat string `json:"dataFormat,omitempty"`
+	// DataDigest is the digest of the export data file, if known.
+	DataDigest *exportFileDigest `json:"dataDigest,omitempty"`
 	// Artifacts is the list of [artifact name, file name] pairs.
 	Artifacts [][2]string `json:"artifacts"`
+	// ArtifactDigests is the list of digests of the artifact files, in the
+	// same order as Artifacts, if known.
+	ArtifactDigests []exportFileDigest `json:"artifactDigests,omitempty"`
+	// Base is the reference to the base export of a layered export.
+	Base *exportManifestBase `json:"base,omitempty"`
+}
+
+// exportManifestBase references the base export of a layered export.
+type exportManifestBase struct {
+	// Dir is the directory of the base export, relative to the directory of
+	// the layered export unless absolute.
+	Dir string `json:"dir"`
+	// BlockHeight is the block height of the base export.
+	BlockHeight uint64 `json:"blockHeight,omitempty"`
+	// ManifestDigest is the digest of the manifest file of the base export.
+	ManifestDigest exportFileDigest `json:"manifestDigest"`
+}
+
+// exportFileDigest is the size and hex encoded SHA-256 hash of a file of an
+// export.
+type exportFileDigest struct {
+	Size   int64  `json:"size"`
+	Sha256 string `json:"sha256"`
+}
+
+// verify returns an error naming the content if its digest doesn't match.
+func (expected exportFileDigest) verify(name string, actual exportFileDigest) error {
+	if actual.Size != expected.Size {
+		return fmt.Errorf("%s has size %d, expected %d", name, actual.Size, expected.Size)
+	}
+	if actual.Sha256 != expected.Sha256 {
+		return fmt.Errorf("%s has SHA-256 %s, expected %s", name, actual.Sha256, expected.Sha256)
+	}
+	return nil
+}
+
+// exportFileHasher is an io.Writer computing the digest of the content
+// written to it.
+type exportFileHasher struct {
+	hash hash.Hash
+	size int64
+}
+
+func newExportFileHasher() *exportFileHasher {
+	return &exportFileHasher{hash: sha256.New()}
+}
+
+// Write implements io.Writer.
+func (hasher *exportFileHasher) Write(p []byte) (int, error) {
+	hasher.size += int64(len(p))
+	return hasher.hash.Write(p)
+}
+
+func (hasher *exportFileHasher) digest() exportFileDigest {
+	return exportFileDigest{
+		Size:   hasher.size,
+		Sha256: hex.EncodeToString(hasher.hash.Sum(nil)),
+	}
+}
+
+func digestOf(data []byte) exportFileDigest {
+	hasher := newExportFileHasher()
+	_, _ = hasher.Write(data)
+	return hasher.digest()
+}
+
+// verifyingReader is an io.ReadCloser which verifies the digest of the
+// content of its source while it is streamed, failing in place of reaching the
+// end of a content which doesn't match.
+type verifyingReader struct {
+	io.ReadCloser
+	name     string
+	expected exportFileDigest
+	hasher   *exportFileHasher
+}
+
+// Read implements io.Reader.
+func (reader *verifyingReader) Read(p []byte) (int, error) {
+	n, err := reader.ReadCloser.Read(p)
+	_, _ = reader.hasher.Write(p[:n])
+	if reader.hasher.size > reader.expected.Size {
+		return n, fmt.Errorf("%s exceeds its expected size %d", reader.name, reader.expected.Size)
+	}
+	if err == io.EOF {
+		if verifyErr := reader.expected.verify(reader.name, reader.hasher.digest()); verifyErr != nil {
+			return n, verifyErr
+		}
+	}
+	return n, err
 }
 
 // ExportManifestFilename is the manifest filename which must be synchronized with the JS export/import tooling
@@ -105,6 +212,10 @@ const ExportManifestFilename = "export-manifest.json"
 // JS and golang handle such extra whitespace.
 const exportDataFilename = "export-data.jsonl"
 
+// binaryExportDataFilename is the name of the "export data" file of exports
+// with binary encoded "export data", which only the golang side understands.
+const binaryExportDataFilename = "export-data.bin"
+
 // UntrustedExportDataArtifactName is a special artifact name that the provider
 // and consumer of an export can use to indicate the presence of a synthetic
 // artifact containing untrusted "export data". This artifact must not end up in
@@ -207,6 +318,17 @@ type SwingStoreExportOptions struct {
 	// SwingStoreExportDataModeAll. If "skip", the reader returned by
 	// SwingStoreExportProvider's GetExportDataReader will be nil.
 	ExportDataMode string `json:"exportDataMode,omitempty"`
+	// BaseExportDir is the directory of a previous export written by
+	// WriteSwingStoreExportToDirectory, set as the BaseExportDir of the
+	// retrieved SwingStoreExportProvider. If empty, the export is not layered.
+	// The JS swing-store export doesn't write the artifacts unchanged since the
+	// base export, but references them from there.
+	// See packages/cosmic-swingset/src/export-kernel-db.js initiateSwingStoreExport
+	BaseExportDir string `json:"baseExportDir,omitempty"`
+	// ExportDataFormat is the format of the "export data" file written by
+	// WriteSwingStoreExportToDirectory, set as the ExportDataFormat of the
+	// retrieved SwingStoreExportProvider. If empty, JSON lines are written.
+	ExportDataFormat string `json:"-"`
 }
 
 // SwingStoreRestoreOptions are configurable options provided to the JS swing-store import
@@ -222,6 +344,11 @@ type SwingStoreRestoreOptions struct {
 	// If RepairMetadata, ArtifactMode should be SwingStoreArtifactModeNone.
 	// If All, ArtifactMode must be at least SwingStoreArtifactModeOperational.
 	ExportDataMode string `json:"exportDataMode,omitempty"`
+	// ResumeDir is the directory in which the export is written before being
+	// restored, preserved if the restore doesn't complete so that a later
+	// restore at the same block height can resume from the artifacts already
+	// written. If empty, a temporary directory is used.
+	ResumeDir string `json:"-"`
 }
 
 type swingStoreImportOptions struct {
@@ -276,19 +403,37 @@ type operationDetails struct {
 	exportDone chan error
 }
 
-// activeOperation is a global variable reflecting a swing-store import or
-// export in progress on the JS side.
-// This variable is only assigned to through calls of the public methods of
+// activeOperationHolder holds the swing-store import or export in progress on
+// the JS side, if any. It is shared by the copies of a SwingStoreExportsHandler.
+// The operation is only assigned through calls of the public methods of
 // SwingStoreExportsHandler, which rely on the exportDone channel getting
-// closed to nil this variable.
-// Only the calls to InitiateExport and RestoreExport set this to a non-nil
-// value. The goroutine in which these calls occur is referred to as the
+// closed to clear it.
+// Only the calls to InitiateExport and RestoreExport set a non-nil operation.
+// The goroutine in which these calls occur is referred to as the
 // "main goroutine". That goroutine may be different over time, but it's the
 // caller's responsibility to ensure those goroutines do not overlap calls to
-// the SwingStoreExportsHandler public methods.
-// See also the details of each field for the conditions under which they are
-// accessed.
-var activeOperation *operationDetails
+// the SwingStoreExportsHandler public methods. The operation is also read by
+// the goroutine of an export operation, hence the mutex.
+// See also the details of each field of operationDetails for the conditions
+// under which they are accessed.
+type activeOperationHolder struct {
+	mutex   sync.Mutex
+	details *operationDetails
+}
+
+// get returns the active operation, or nil if there is none.
+func (active *activeOperationHolder) get() *operationDetails {
+	active.mutex.Lock()
+	defer active.mutex.Unlock()
+	return active.details
+}
+
+// set replaces the active operation, or clears it if nil.
+func (active *activeOperationHolder) set(details *operationDetails) {
+	active.mutex.Lock()
+	defer active.mutex.Unlock()
+	active.details = details
+}
 
 // WaitUntilSwingStoreExportStarted synchronizes with an export operation in
 // progress, if any.
@@ -302,8 +447,8 @@ var activeOperation *operationDetails
 // returns immediately.
 //
 // Must be called by the main goroutine
-func WaitUntilSwingStoreExportStarted() error {
-	operationDetails := activeOperation
+func (exportsHandler SwingStoreExportsHandler) WaitUntilSwingStoreExportStarted() error {
+	operationDetails := exportsHandler.activeOperation.get()
 	if operationDetails == nil {
 		return nil
 	}
@@ -313,19 +458,19 @@ func WaitUntilSwingStoreExportStarted() error {
 	// Only the first call after an export was initiated will report an error.
 	startErr := <-operationDetails.exportStartedResult
 
-	// Check if the active export operation is done, and if so, nil it out so
+	// Check if the active export operation is done, and if so, clear it so
 	// future calls are faster.
 	select {
 	case <-operationDetails.exportDone:
 		// If there was a start error, the channel is already closed at this point.
-		activeOperation = nil
+		exportsHandler.activeOperation.set(nil)
 	default:
 		// don't wait for it to finish
 		// If there is no start error, the operation may take an arbitrary amount
 		// of time to terminate, likely spanning multiple blocks. However this
-		// function will only ever observe the expected activeOperation since the
+		// function will only ever observe the expected active operation since the
 		// internal checkNotActive() called immediately on InitiateSnapshot will
-		// nil-out activeOperation if a stale value was sill sitting around.
+		// clear the active operation if a stale value was sill sitting around.
 	}
 
 	return startErr
@@ -346,8 +491,8 @@ func WaitUntilSwingStoreExportStarted() error {
 // returns immediately.
 //
 // Must be called by the main goroutine
-func WaitUntilSwingStoreExportDone() error {
-	operationDetails := activeOperation
+func (exportsHandler SwingStoreExportsHandler) WaitUntilSwingStoreExportDone() error {
+	operationDetails := exportsHandler.activeOperation.get()
 	if operationDetails == nil {
 		return nil
 	}
@@ -356,7 +501,7 @@ func WaitUntilSwingStoreExportDone() error {
 	// and closes the channel once the export has completed or failed.
 	// Only the first call after an export was initiated will report an error.
 	exportErr := <-operationDetails.exportDone
-	activeOperation = nil
+	exportsHandler.activeOperation.set(nil)
 
 	return exportErr
 }
@@ -364,13 +509,13 @@ func WaitUntilSwingStoreExportDone() error {
 // checkNotActive returns an error if there is an active operation.
 //
 // Always internally called by the main goroutine
-func checkNotActive() error {
-	operationDetails := activeOperation
+func (exportsHandler SwingStoreExportsHandler) checkNotActive() error {
+	operationDetails := exportsHandler.activeOperation.get()
 	if operationDetails != nil {
 		select {
 		case <-operationDetails.exportDone:
-			// nil-out any stale operation
-			activeOperation = nil
+			// clear any stale operation
+			exportsHandler.activeOperation.set(nil)
 		default:
 			if operationDetails.isRestore {
 				return fmt.Errorf("restore operation already in progress for height %d", operationDetails.blockHeight)
@@ -407,6 +552,14 @@ type SwingStoreExportProvider struct {
 	// ReadNextArtifact is a function to return the next unread artifact in the SwingStore export.
 	// It errors with io.EOF upon reaching the end of the list of available artifacts.
 	ReadNextArtifact func() (types.SwingStoreArtifact, error)
+	// BaseExportDir is the directory of a previous export written by
+	// WriteSwingStoreExportToDirectory. If set, WriteSwingStoreExportToDirectory
+	// writes a layered export, without the artifacts unchanged since the base.
+	BaseExportDir string
+	// ExportDataFormat is the format of the "export data" file written by
+	// WriteSwingStoreExportToDirectory, like "binary+zstd". If empty, JSON
+	// lines are written.
+	ExportDataFormat string
 }
 
 // SwingStoreExportEventHandler is used to handle events that occur while generating
@@ -446,15 +599,17 @@ type SwingStoreExportEventHandler interface {
 // must happen before the Swingset controller on the JS side was inited, in
 // which case the mustNotBeInited parameter will be set to true.
 type SwingStoreExportsHandler struct {
-	logger       log.Logger
-	blockingSend func(action vm.Jsonable, mustNotBeInited bool) (string, error)
+	logger          log.Logger
+	blockingSend    func(action vm.Jsonable, mustNotBeInited bool) (string, error)
+	activeOperation *activeOperationHolder
 }
 
 // NewSwingStoreExportsHandler creates a SwingStoreExportsHandler
 func NewSwingStoreExportsHandler(logger log.Logger, blockingSend func(action vm.Jsonable, mustNotBeInited bool) (string, error)) *SwingStoreExportsHandler {
 	return &SwingStoreExportsHandler{
-		logger:       logger.With("module", fmt.Sprintf("x/%s", types.ModuleName), "submodule", "SwingStoreExportsHandler"),
-		blockingSend: blockingSend,
+		logger:          logger.With("module", fmt.Sprintf("x/%s", types.ModuleName), "submodule", "SwingStoreExportsHandler"),
+		blockingSend:    blockingSend,
+		activeOperation: &activeOperationHolder{},
 	}
 }
 
@@ -473,11 +628,19 @@ func NewSwingStoreExportsHandler(logger log.Logger, blockingSend func(action vm.
 //
 // Must be called by the main goroutine
 func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64, eventHandler SwingStoreExportEventHandler, exportOptions SwingStoreExportOptions) error {
-	err := checkNotActive()
+	err := exportsHandler.checkNotActive()
 	if err != nil {
 		return err
 	}
 
+	if exportOptions.BaseExportDir != "" {
+		// The JS swing-store export may not share our working directory.
+		exportOptions.BaseExportDir, err = filepath.Abs(exportOptions.BaseExportDir)
+		if err != nil {
+			return err
+		}
+	}
+
 	var logger log.Logger
 	if blockHeight != 0 {
 		logger = exportsHandler.logger.With("height", blockHeight)
@@ -485,8 +648,8 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 		logger = exportsHandler.logger.With("height", "latest")
 	}
 
-	// Indicate that an export operation has been initiated by setting the global
-	// activeOperation var.
+	// Indicate that an export operation has been initiated by setting the
+	// active operation of the handler.
 	// This structure is used to synchronize with the goroutine spawned below.
 	operationDetails := &operationDetails{
 		blockHeight:         blockHeight,
@@ -495,7 +658,7 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 		exportRetrieved:     false,
 		exportDone:          make(chan error, 1),
 	}
-	activeOperation = operationDetails
+	exportsHandler.activeOperation.set(operationDetails)
 
 	go func() {
 		var err error
@@ -546,13 +709,13 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 		// The user provided OnExportStarted function should call retrieveExport()
 		var retrieveErr error
 		err = eventHandler.OnExportStarted(blockHeight, func() error {
-			activeOperationDetails := activeOperation
+			activeOperationDetails := exportsHandler.activeOperation.get()
 			if activeOperationDetails != operationDetails || operationDetails.exportRetrieved {
 				// shouldn't happen, but return an error if it does
 				return errors.New("export operation no longer active")
 			}
 
-			retrieveErr = exportsHandler.retrieveExport(eventHandler.OnExportRetrieved)
+			retrieveErr = exportsHandler.retrieveExport(exportOptions, eventHandler.OnExportRetrieved)
 
 			return retrieveErr
 		})
@@ -604,14 +767,15 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 // SwingStoreExportProvider for the onExportRetrieved callback to access the
 // retrieved swing-store export.
 // The export manifest format is described by the exportManifest struct.
+// The provider has the BaseExportDir and ExportDataFormat of the exportOptions.
 //
 // After calling onExportRetrieved, the export directory and its contents are
 // deleted.
 //
 // This will block until the export is ready. Internally invoked by the
 // InitiateExport logic in the export operation's goroutine.
-func (exportsHandler SwingStoreExportsHandler) retrieveExport(onExportRetrieved func(provider SwingStoreExportProvider) error) (err error) {
-	operationDetails := activeOperation
+func (exportsHandler SwingStoreExportsHandler) retrieveExport(exportOptions SwingStoreExportOptions, onExportRetrieved func(provider SwingStoreExportProvider) error) (err error) {
+	operationDetails := exportsHandler.activeOperation.get()
 	if operationDetails == nil {
 		// shouldn't happen, but return an error if it does
 		return errors.New("no active swing-store export operation")
@@ -646,6 +810,8 @@ func (exportsHandler SwingStoreExportsHandler) retrieveExport(onExportRetrieved
 	if blockHeight != 0 && provider.BlockHeight != blockHeight {
 		return fmt.Errorf("export manifest blockHeight (%d) doesn't match (%d)", provider.BlockHeight, blockHeight)
 	}
+	provider.BaseExportDir = exportOptions.BaseExportDir
+	provider.ExportDataFormat = exportOptions.ExportDataFormat
 
 	err = onExportRetrieved(provider)
 	if err != nil {
@@ -657,21 +823,107 @@ func (exportsHandler SwingStoreExportsHandler) retrieveExport(onExportRetrieved
 	return nil
 }
 
+// exportLayer is an export directory, whose artifacts may be read from the
+// directories of its base exports if it is layered.
+type exportLayer struct {
+	dir            string
+	manifest       exportManifest
+	manifestDigest exportFileDigest
+	// artifactIndices maps the artifact names to their index in the manifest.
+	artifactIndices map[string]int
+	base            *exportLayer
+}
+
+// openExportLayer reads the export manifest of a directory, verifying it
+// against the expected digest if any, and opens its base exports.
+func openExportLayer(exportDir string, expectedDigest *exportFileDigest) (*exportLayer, error) {
+	rawManifest, err := os.ReadFile(filepath.Join(exportDir, ExportManifestFilename))
+	if err != nil {
+		return nil, err
+	}
+	layer := &exportLayer{
+		dir:             exportDir,
+		manifestDigest:  digestOf(rawManifest),
+		artifactIndices: map[string]int{},
+	}
+	if expectedDigest != nil {
+		err = expectedDigest.verify(fmt.Sprintf("export manifest of %s", exportDir), layer.manifestDigest)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = json.Unmarshal(rawManifest, &layer.manifest)
+	if err != nil {
+		return nil, err
+	}
+	manifest := layer.manifest
+	if manifest.ArtifactDigests != nil && len(manifest.ArtifactDigests) != len(manifest.Artifacts) {
+		return nil, fmt.Errorf("export manifest has %d artifact digests for %d artifacts", len(manifest.ArtifactDigests), len(manifest.Artifacts))
+	}
+	for index, artifactEntry := range manifest.Artifacts {
+		layer.artifactIndices[artifactEntry[0]] = index
+	}
+
+	if manifest.Base != nil {
+		if manifest.ArtifactDigests == nil {
+			return nil, fmt.Errorf("layered export manifest of %s has no artifact digests", exportDir)
+		}
+		baseDir := manifest.Base.Dir
+		if !filepath.IsAbs(baseDir) {
+			baseDir = filepath.Join(exportDir, baseDir)
+		}
+		layer.base, err = openExportLayer(baseDir, &manifest.Base.ManifestDigest)
+		if err != nil {
+			return nil, fmt.Errorf("cannot open base export: %w", err)
+		}
+	}
+	return layer, nil
+}
+
+// readArtifact reads the artifact at the given index of the manifest, from
+// the base export if it has no file in this directory.
+func (layer *exportLayer) readArtifact(index int) ([]byte, error) {
+	artifactName := layer.manifest.Artifacts[index][0]
+	fileName := layer.manifest.Artifacts[index][1]
+
+	var data []byte
+	var err error
+	if fileName != "" {
+		data, err = os.ReadFile(filepath.Join(layer.dir, fileName))
+	} else if layer.base == nil {
+		err = fmt.Errorf("artifact %s has no file name", artifactName)
+	} else if baseIndex, ok := layer.base.artifactIndices[artifactName]; !ok {
+		err = fmt.Errorf("artifact %s is missing from base export %s", artifactName, layer.base.dir)
+	} else {
+		data, err = layer.base.readArtifact(baseIndex)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if layer.manifest.ArtifactDigests != nil {
+		err = layer.manifest.ArtifactDigests[index].verify(fmt.Sprintf("artifact %s", artifactName), digestOf(data))
+	}
+	return data, err
+}
+
 // OpenSwingStoreExportDirectory creates an export provider from a swing-store
 // export saved on disk in the provided directory. It expects the export manifest
 // to be present in that directory. The provider's function will read the
 // export's data and artifacts from disk on demand. Each artifact is using a
 // dedicated file, and the export data is read from a jsonl-like file, if any.
+// The artifacts of a layered export are read from its base exports as needed,
+// so that the provider represents the full export.
 // The export manifest filename and overall export format is common with the JS
 // swing-store import/export logic.
 func OpenSwingStoreExportDirectory(exportDir string) (SwingStoreExportProvider, error) {
-	rawManifest, err := os.ReadFile(filepath.Join(exportDir, ExportManifestFilename))
+	layer, err := openExportLayer(exportDir, nil)
 	if err != nil {
 		return SwingStoreExportProvider{}, err
 	}
-
-	var manifest exportManifest
-	err = json.Unmarshal(rawManifest, &manifest)
+	manifest := layer.manifest
+	dataFormat, err := parseExportDataFormat(manifest.DataFormat)
 	if err != nil {
 		return SwingStoreExportProvider{}, err
 	}
@@ -685,8 +937,16 @@ func OpenSwingStoreExportDirectory(exportDir string) (SwingStoreExportProvider,
 		if err != nil {
 			return nil, err
 		}
-		exportDataReader := agoric.NewJsonlKVEntryDecoderReader(dataFile)
-		return exportDataReader, nil
+		var dataReader io.ReadCloser = dataFile
+		if manifest.DataDigest != nil {
+			dataReader = &verifyingReader{
+				ReadCloser: dataFile,
+				name:       fmt.Sprintf("export data %s", manifest.Data),
+				expected:   *manifest.DataDigest,
+				hasher:     newExportFileHasher(),
+			}
+		}
+		return dataFormat.newReader(dataReader)
 	}
 
 	nextArtifact := 0
@@ -698,34 +958,68 @@ func OpenSwingStoreExportDirectory(exportDir string) (SwingStoreExportProvider,
 			return artifact, fmt.Errorf("exceeded expected artifact count: %d > %d", nextArtifact, len(manifest.Artifacts))
 		}
 
-		artifactEntry := manifest.Artifacts[nextArtifact]
+		index := nextArtifact
 		nextArtifact++
 
-		artifactName := artifactEntry[0]
-		fileName := artifactEntry[1]
+		artifactName := manifest.Artifacts[index][0]
 		if artifactName == UntrustedExportDataArtifactName {
 			return artifact, fmt.Errorf("unexpected export artifact name %s", artifactName)
 		}
 		artifact.Name = artifactName
-		artifact.Data, err = os.ReadFile(filepath.Join(exportDir, fileName))
-
+		artifact.Data, err = layer.readArtifact(index)
 		return artifact, err
 	}
 
 	return SwingStoreExportProvider{BlockHeight: manifest.BlockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readNextArtifact}, nil
 }
 
+// openBaseExport opens the base export of a layered export written in
+// exportDir, returning the manifest reference to it.
+func openBaseExport(exportDir, baseExportDir string) (*exportLayer, *exportManifestBase, error) {
+	base, err := openExportLayer(baseExportDir, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot open base export: %w", err)
+	}
+	if base.manifest.ArtifactDigests == nil {
+		return nil, nil, fmt.Errorf("base export %s has no artifact digests", baseExportDir)
+	}
+
+	// Reference the base relative to the layered export, so that they can be
+	// moved together.
+	dir, err := filepath.Abs(baseExportDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	absExportDir, err := filepath.Abs(exportDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	if relDir, err := filepath.Rel(absExportDir, dir); err == nil {
+		dir = relDir
+	}
+
+	return base, &exportManifestBase{
+		Dir:            dir,
+		BlockHeight:    base.manifest.BlockHeight,
+		ManifestDigest: base.manifestDigest,
+	}, nil
+}
+
 // RestoreExport restores the JS swing-store using previously exported data and artifacts.
 //
 // Must be called by the main goroutine
 func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStoreExportProvider, restoreOptions SwingStoreRestoreOptions) error {
-	err := checkNotActive()
+	err := exportsHandler.checkNotActive()
 	if err != nil {
 		return err
 	}
 
 	blockHeight := provider.BlockHeight
 
+	// The JS side only imports flat exports with JSON lines "export data".
+	provider.BaseExportDir = ""
+	provider.ExportDataFormat = ""
+
 	// We technically don't need to create an active operation here since both
 	// InitiateExport and RestoreExport should only be called from the main
 	// goroutine, but it doesn't cost much to add in case things go wrong.
@@ -740,20 +1034,34 @@ func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStore
 		exportStartedResult: nil,
 		exportDone:          nil,
 	}
-	activeOperation = operationDetails
+	exportsHandler.activeOperation.set(operationDetails)
 	defer func() {
-		activeOperation = nil
+		exportsHandler.activeOperation.set(nil)
 	}()
 
-	exportDir, err := os.MkdirTemp("", fmt.Sprintf("agd-swing-store-restore-%d-*", blockHeight))
-	if err != nil {
-		return err
+	var exportDir string
+	var progress *restoreProgress
+	if restoreOptions.ResumeDir == "" {
+		exportDir, err = os.MkdirTemp("", fmt.Sprintf("agd-swing-store-restore-%d-*", blockHeight))
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(exportDir)
+	} else {
+		exportDir = restoreOptions.ResumeDir
+		progress, err = openRestoreProgress(exportDir, blockHeight)
+		if err != nil {
+			return err
+		}
+		defer progress.Close()
+		if len(progress.written) > 0 {
+			exportsHandler.logger.Info("resuming swing-store restore", "exportDir", exportDir, "height", blockHeight, "artifactsWritten", len(progress.written))
+		}
 	}
-	defer os.RemoveAll(exportDir)
 
 	exportsHandler.logger.Info("creating swing-store restore", "exportDir", exportDir, "height", blockHeight)
 
-	err = WriteSwingStoreExportToDirectory(provider, exportDir)
+	err = writeSwingStoreExportToDirectory(provider, exportDir, progress)
 	if err != nil {
 		return err
 	}
@@ -778,6 +1086,13 @@ func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStore
 
 	exportsHandler.logger.Info("restored swing-store", "exportDir", exportDir, "height", blockHeight)
 
+	if progress != nil {
+		// The restore is complete, so there is nothing left to resume.
+		if err := os.RemoveAll(exportDir); err != nil {
+			exportsHandler.logger.Error("failed to remove swing-store restore directory", "exportDir", exportDir, "err", err)
+		}
+	}
+
 	return nil
 }
 
@@ -787,7 +1102,47 @@ func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStore
 // a jsonl-like file, before saving the export manifest linking these together.
 // The export manifest filename and overall export format is common with the JS
 // swing-store import/export logic.
-func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportDir string) (err error) {
+// If the provider has a BaseExportDir, a layered export is written, without
+// the files of the artifacts with the same name and content in the base. The
+// "export data" is written in the ExportDataFormat of the provider.
+// Artifacts are read from the provider in order, but written to disk by
+// exportArtifactWriters concurrent writers.
+func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportDir string) error {
+	return writeSwingStoreExportToDirectory(provider, exportDir, nil)
+}
+
+// exportArtifactWriters is the number of artifacts written to disk
+// concurrently by WriteSwingStoreExportToDirectory.
+const exportArtifactWriters = 4
+
+// artifactWrite is an artifact file to write to an export directory.
+type artifactWrite struct {
+	entry restoreProgressEntry
+	data  []byte
+}
+
+// writeExportArtifactFile writes and syncs an artifact file, so that it can
+// be recorded as written in a restore progress journal.
+func writeExportArtifactFile(path string, data []byte) (err error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, exportedFilesMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	if _, err = file.Write(data); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// writeSwingStoreExportToDirectory implements WriteSwingStoreExportToDirectory,
+// skipping the artifacts that the progress journal, if any, records as already
+// written, and recording the others once written.
+func writeSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportDir string, progress *restoreProgress) (err error) {
 	handleDeferError := func(fn func() error) {
 		deferError := fn()
 		if err == nil {
@@ -803,6 +1158,19 @@ func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportD
 		BlockHeight: provider.BlockHeight,
 	}
 
+	dataFormat, err := parseExportDataFormat(provider.ExportDataFormat)
+	if err != nil {
+		return err
+	}
+
+	var base *exportLayer
+	if provider.BaseExportDir != "" {
+		base, manifest.Base, err = openBaseExport(exportDir, provider.BaseExportDir)
+		if err != nil {
+			return err
+		}
+	}
+
 	exportDataReader, err := provider.GetExportDataReader()
 	if err != nil {
 		return err
@@ -811,17 +1179,21 @@ func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportD
 	if exportDataReader != nil {
 		defer handleDeferError(exportDataReader.Close)
 
-		manifest.Data = exportDataFilename
-		exportDataFile, err := os.OpenFile(filepath.Join(exportDir, exportDataFilename), os.O_CREATE|os.O_WRONLY, exportedFilesMode)
+		manifest.Data = dataFormat.filename()
+		manifest.DataFormat = dataFormat.manifestValue()
+		exportDataFile, err := os.OpenFile(filepath.Join(exportDir, manifest.Data), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, exportedFilesMode)
 		if err != nil {
 			return err
 		}
 		defer handleDeferError(exportDataFile.Close)
 
-		err = agoric.EncodeKVEntryReaderToJsonl(exportDataReader, exportDataFile)
+		hasher := newExportFileHasher()
+		err = dataFormat.encode(exportDataReader, io.MultiWriter(exportDataFile, hasher))
 		if err != nil {
 			return err
 		}
+		dataDigest := hasher.digest()
+		manifest.DataDigest = &dataDigest
 
 		err = exportDataFile.Sync()
 		if err != nil {
@@ -833,15 +1205,69 @@ func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportD
 		return os.WriteFile(filepath.Join(exportDir, filename), data, exportedFilesMode)
 	}
 
-	for {
-		artifact, err := provider.ReadNextArtifact()
-		if err == io.EOF {
-			break
-		} else if err != nil {
+	// Artifacts are written by a pool of writers fed in order. The first write
+	// error stops reading further artifacts.
+	writes := make(chan artifactWrite, exportArtifactWriters)
+	writeErrors := make(chan error, exportArtifactWriters)
+	done := make(chan struct{})
+	var writers sync.WaitGroup
+	for i := 0; i < exportArtifactWriters; i++ {
+		writers.Add(1)
+		go func() {
+			defer writers.Done()
+			for write := range writes {
+				err := writeExportArtifactFile(filepath.Join(exportDir, write.entry.Filename), write.data)
+				if err == nil && progress != nil {
+					err = progress.record(write.entry)
+				}
+				if err != nil {
+					writeErrors <- fmt.Errorf("cannot write artifact %s: %w", write.entry.Name, err)
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		writers.Wait()
+		close(done)
+	}()
+	var closeWrites sync.Once
+	waitForWriters := func() error {
+		closeWrites.Do(func() { close(writes) })
+		<-done
+		select {
+		case err := <-writeErrors:
+			return err
+		default:
+			return nil
+		}
+	}
+	queueWrite := func(write artifactWrite) error {
+		select {
+		case writes <- write:
+			return nil
+		case err := <-writeErrors:
 			return err
 		}
+	}
+
+	err = func() error {
+		for {
+			artifact, err := provider.ReadNextArtifact()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if artifact.Name == UntrustedExportDataArtifactName {
+				// Pseudo artifact containing untrusted export data which may have been
+				// saved separately for debugging purposes (not referenced from the manifest)
+				if err := writeExportFile(untrustedExportDataFilename, artifact.Data); err != nil {
+					return err
+				}
+				continue
+			}
 
-		if artifact.Name != UntrustedExportDataArtifactName {
 			// An artifact is only verifiable by the JS swing-store import using the
 			// information contained in the "export data".
 			// Since we cannot trust the source of the artifact at this point,
@@ -850,18 +1276,38 @@ func WriteSwingStoreExportToDirectory(provider SwingStoreExportProvider, exportD
 			// any non letters-digits-hyphen-underscore-dot by a hyphen, and
 			// prefixing with an incremented id.
 			// The filename is not used for any purpose in the import logic.
+			index := len(manifest.Artifacts)
 			filename := sanitizeArtifactName(artifact.Name)
-			filename = fmt.Sprintf("%d-%s", len(manifest.Artifacts), filename)
+			filename = fmt.Sprintf("%d-%s", index, filename)
+			digest := digestOf(artifact.Data)
+			manifest.ArtifactDigests = append(manifest.ArtifactDigests, digest)
+			if base != nil {
+				baseIndex, ok := base.artifactIndices[artifact.Name]
+				if ok && base.manifest.ArtifactDigests[baseIndex] == digest {
+					// Unchanged since the base export, so read from there.
+					manifest.Artifacts = append(manifest.Artifacts, [2]string{artifact.Name, ""})
+					continue
+				}
+			}
 			manifest.Artifacts = append(manifest.Artifacts, [2]string{artifact.Name, filename})
-			err = writeExportFile(filename, artifact.Data)
-		} else {
-			// Pseudo artifact containing untrusted export data which may have been
-			// saved separately for debugging purposes (not referenced from the manifest)
-			err = writeExportFile(untrustedExportDataFilename, artifact.Data)
+
+			entry := restoreProgressEntry{Index: index, Name: artifact.Name, Filename: filename, Digest: digest}
+			if progress != nil && progress.isWritten(entry) {
+				continue
+			}
+			if err := queueWrite(artifactWrite{entry: entry, data: artifact.Data}); err != nil {
+				return err
+			}
 		}
-		if err != nil {
-			return err
+	}()
+	if err != nil {
+		if waitErr := waitForWriters(); waitErr != nil {
+			err = sdkioerrors.Wrapf(err, "deferred error %+v", waitErr)
 		}
+		return err
+	}
+	if err = waitForWriters(); err != nil {
+		return err
 	}
 
 	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
@@ -1,19 +1,27 @@
 package keeper
 
 import (
+	"encoding/json"
 	"errors"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
 func newTestSwingStoreExportsHandler() *SwingStoreExportsHandler {
 	logger := log.NewNopLogger() // log.NewTMLogger(log.NewSyncWriter( /* os.Stdout*/ io.Discard)).With("module", "sdk/app")
 	return &SwingStoreExportsHandler{
-		logger:       logger,
-		blockingSend: func(action vm.Jsonable, mustNotBeInited bool) (string, error) { return "", nil },
+		logger:          logger,
+		blockingSend:    func(action vm.Jsonable, mustNotBeInited bool) (string, error) { return "", nil },
+		activeOperation: &activeOperationHolder{},
 	}
 }
 
@@ -62,7 +70,7 @@ func TestSwingStoreSnapshotterInProgress(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportStarted()
+	err = exportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -78,7 +86,7 @@ func TestSwingStoreSnapshotterInProgress(t *testing.T) {
 	}
 
 	close(ch)
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -86,7 +94,7 @@ func TestSwingStoreSnapshotterInProgress(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -104,7 +112,7 @@ func TestSwingStoreSnapshotterSecondCommit(t *testing.T) {
 	}
 
 	// First run through app.Commit()
-	err := WaitUntilSwingStoreExportStarted()
+	err := exportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -114,14 +122,14 @@ func TestSwingStoreSnapshotterSecondCommit(t *testing.T) {
 	}
 
 	// Second run through app.Commit() - should return right away
-	err = WaitUntilSwingStoreExportStarted()
+	err = exportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// close the signaling channel to let goroutine exit
 	close(ch)
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -142,7 +150,7 @@ func TestSwingStoreSnapshotterInitiateFails(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportStarted()
+	err = exportsHandler.WaitUntilSwingStoreExportStarted()
 	if err == nil {
 		t.Fatal("wanted initiation error")
 	}
@@ -150,16 +158,58 @@ func TestSwingStoreSnapshotterInitiateFails(t *testing.T) {
 		t.Errorf(`wanted error "initiate failed", got "%s"`, err.Error())
 	}
 	// another wait should succeed without error
-	err = WaitUntilSwingStoreExportStarted()
+	err = exportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Error(err)
 	}
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
 }
 
+func TestSwingStoreExportsHandlerBaseExportDir(t *testing.T) {
+	exportsHandler := newTestSwingStoreExportsHandler()
+	var baseExportDir string
+	exportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+		if initiateAction, ok := action.(*swingStoreInitiateExportAction); ok {
+			bz, err := json.Marshal(initiateAction)
+			if err != nil {
+				return "", err
+			}
+			var sent struct {
+				Args []struct {
+					BaseExportDir string `json:"baseExportDir"`
+				} `json:"args"`
+			}
+			if err := json.Unmarshal(bz, &sent); err != nil {
+				return "", err
+			}
+			baseExportDir = sent.Args[0].BaseExportDir
+		}
+		return "", nil
+	}
+
+	eventHandler := newTestSwingStoreEventHandler()
+	eventHandler.onExportStarted = func(height uint64, retrieveExport func() error) error {
+		return nil
+	}
+	err := exportsHandler.InitiateExport(123, eventHandler, SwingStoreExportOptions{BaseExportDir: "base"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := exportsHandler.WaitUntilSwingStoreExportDone(); err != nil {
+		t.Fatal(err)
+	}
+	expected, err := filepath.Abs("base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if baseExportDir != expected {
+		t.Errorf("got base export dir %q sent to the VM, want %q", baseExportDir, expected)
+	}
+}
+
 func TestSwingStoreSnapshotterRetrievalFails(t *testing.T) {
 	exportsHandler := newTestSwingStoreExportsHandler()
 	var retrieveError error
@@ -184,18 +234,59 @@ func TestSwingStoreSnapshotterRetrievalFails(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportStarted()
+	err = exportsHandler.WaitUntilSwingStoreExportStarted()
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	close(ch)
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
+	if err != retrieveError {
+		t.Errorf(`wanted retrieval error, got "%v"`, err)
+	}
+	// The export goroutine is done, so its result can be read.
 	if savedErr != retrieveError {
 		t.Errorf(`wanted retrieval error, got "%v"`, savedErr)
 	}
-	err = WaitUntilSwingStoreExportDone()
-	if err != retrieveError {
-		t.Errorf(`wanted retrieval error, got "%v"`, err)
+}
+
+func TestSwingStoreExportsHandlerInstances(t *testing.T) {
+	exportsHandlerA := newTestSwingStoreExportsHandler()
+	exportsHandlerB := newTestSwingStoreExportsHandler()
+	ch := make(chan struct{})
+	exportEventHandler := newTestSwingStoreEventHandler()
+	exportEventHandler.onExportStarted = func(height uint64, retrieveExport func() error) error {
+		<-ch
+		return nil
+	}
+
+	err := exportsHandlerA.InitiateExport(123, exportEventHandler, SwingStoreExportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = exportsHandlerA.WaitUntilSwingStoreExportStarted()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The operation in progress for one instance doesn't affect another.
+	otherEventHandler := newTestSwingStoreEventHandler()
+	otherEventHandler.onExportStarted = func(height uint64, retrieveExport func() error) error {
+		return nil
+	}
+	err = exportsHandlerB.InitiateExport(123, otherEventHandler, SwingStoreExportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = exportsHandlerB.WaitUntilSwingStoreExportDone()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	close(ch)
+	err = exportsHandlerA.WaitUntilSwingStoreExportDone()
+	if err != nil {
+		t.Fatal(err)
 	}
 }
 
@@ -213,14 +304,14 @@ func TestSwingStoreSnapshotterDiscard(t *testing.T) {
 	// simulate an onExportStarted which successfully calls retrieveExport()
 	exportEventHandler := newTestSwingStoreEventHandler()
 	exportEventHandler.onExportStarted = func(height uint64, retrieveExport func() error) error {
-		activeOperation.exportRetrieved = true
+		exportsHandler.activeOperation.get().exportRetrieved = true
 		return nil
 	}
 	err := exportsHandler.InitiateExport(123, exportEventHandler, SwingStoreExportOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -237,7 +328,7 @@ func TestSwingStoreSnapshotterDiscard(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = WaitUntilSwingStoreExportDone()
+	err = exportsHandler.WaitUntilSwingStoreExportDone()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -245,3 +336,260 @@ func TestSwingStoreSnapshotterDiscard(t *testing.T) {
 		t.Error("wanted discard called")
 	}
 }
+
+func newTestSwingStoreExportProvider(blockHeight uint64) SwingStoreExportProvider {
+	artifacts := []types.SwingStoreArtifact{
+		{Name: "bundle.b1-1234", Data: []byte("bundle data")},
+		{Name: "transcript.v1.1.2", Data: []byte("transcript data")},
+		{Name: "snapshot.v1.42", Data: []byte("heap snapshot data")},
+	}
+	return SwingStoreExportProvider{
+		BlockHeight: blockHeight,
+		GetExportDataReader: func() (agoric.KVEntryReader, error) {
+			return agoric.NewSwingStoreExportDataEntriesReader([]*types.SwingStoreExportDataEntry{
+				{Key: "bundle.b1-1234", Value: "1234"},
+				{Key: "transcript.v1.current", Value: "{}"},
+			}), nil
+		},
+		ReadNextArtifact: func() (types.SwingStoreArtifact, error) {
+			if len(artifacts) == 0 {
+				return types.SwingStoreArtifact{}, io.EOF
+			}
+			artifact := artifacts[0]
+			artifacts = artifacts[1:]
+			return artifact, nil
+		},
+	}
+}
+
+func writeTestSwingStoreExport(t *testing.T) string {
+	exportDir := t.TempDir()
+	if err := WriteSwingStoreExportToDirectory(newTestSwingStoreExportProvider(42), exportDir); err != nil {
+		t.Fatal(err)
+	}
+	return exportDir
+}
+
+func readTestSwingStoreExport(exportDir string) error {
+	provider, err := OpenSwingStoreExportDirectory(exportDir)
+	if err != nil {
+		return err
+	}
+	exportDataReader, err := provider.GetExportDataReader()
+	if err != nil {
+		return err
+	}
+	defer exportDataReader.Close()
+	for {
+		if _, err := exportDataReader.Read(); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	for {
+		if _, err := provider.ReadNextArtifact(); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func TestSwingStoreExportDirectoryDigests(t *testing.T) {
+	exportDir := writeTestSwingStoreExport(t)
+	if err := readTestSwingStoreExport(exportDir); err != nil {
+		t.Fatalf("unexpected error reading intact export: %v", err)
+	}
+
+	// A corrupted artifact is reported by name.
+	exportDir = writeTestSwingStoreExport(t)
+	if err := os.WriteFile(filepath.Join(exportDir, "1-transcript.v1.1.2"), []byte("transcript datA"), exportedFilesMode); err != nil {
+		t.Fatal(err)
+	}
+	err := readTestSwingStoreExport(exportDir)
+	if err == nil || !strings.Contains(err.Error(), "artifact transcript.v1.1.2") {
+		t.Errorf("got error %v, want corrupted artifact error", err)
+	}
+
+	// A truncated export data file is reported.
+	exportDir = writeTestSwingStoreExport(t)
+	dataPath := filepath.Join(exportDir, exportDataFilename)
+	data, err := os.ReadFile(dataPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+	if err := os.WriteFile(dataPath, []byte(lines[0]), exportedFilesMode); err != nil {
+		t.Fatal(err)
+	}
+	err = readTestSwingStoreExport(exportDir)
+	if err == nil || !strings.Contains(err.Error(), "export data") {
+		t.Errorf("got error %v, want truncated export data error", err)
+	}
+}
+
+func TestSwingStoreRestoreResume(t *testing.T) {
+	exportsHandler := newTestSwingStoreExportsHandler()
+	resumeDir := filepath.Join(t.TempDir(), "restore")
+	restoreOptions := SwingStoreRestoreOptions{ResumeDir: resumeDir}
+
+	// The first restore attempt fails after all the artifacts were written.
+	exportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+		return "", errors.New("interrupted")
+	}
+	if err := exportsHandler.RestoreExport(newTestSwingStoreExportProvider(42), restoreOptions); err == nil {
+		t.Fatal("wanted error for interrupted restore")
+	}
+
+	// Mark a written artifact, and remove another which must be written again.
+	past := time.Unix(1_000_000, 0)
+	if err := os.Chtimes(filepath.Join(resumeDir, "0-bundle.b1-1234"), past, past); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(resumeDir, "1-transcript.v1.1.2")); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := false
+	exportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+		restored = true
+		exportDir := action.(*swingStoreRestoreExportAction).Args[0].ExportDir
+		if info, err := os.Stat(filepath.Join(exportDir, "0-bundle.b1-1234")); err != nil || !info.ModTime().Equal(past) {
+			t.Errorf("already written artifact was written again")
+		}
+		return "", readTestSwingStoreExport(exportDir)
+	}
+	if err := exportsHandler.RestoreExport(newTestSwingStoreExportProvider(42), restoreOptions); err != nil {
+		t.Fatal(err)
+	}
+	if !restored {
+		t.Error("export was not restored")
+	}
+	if _, err := os.Stat(resumeDir); !os.IsNotExist(err) {
+		t.Errorf("restore directory not removed after restore: %v", err)
+	}
+
+	// A restore at another height doesn't reuse the artifacts.
+	exportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+		return "", errors.New("interrupted")
+	}
+	if err := exportsHandler.RestoreExport(newTestSwingStoreExportProvider(42), restoreOptions); err == nil {
+		t.Fatal("wanted error for interrupted restore")
+	}
+	progress, err := openRestoreProgress(resumeDir, 43)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer progress.Close()
+	if len(progress.written) != 0 {
+		t.Errorf("got %d written artifacts for another height, want 0", len(progress.written))
+	}
+	if _, err := os.Stat(filepath.Join(resumeDir, "0-bundle.b1-1234")); !os.IsNotExist(err) {
+		t.Errorf("artifact of another height not discarded: %v", err)
+	}
+}
+
+func TestSwingStoreLayeredExport(t *testing.T) {
+	root := t.TempDir()
+	baseDir := filepath.Join(root, "base")
+	if err := os.Mkdir(baseDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteSwingStoreExportToDirectory(newTestSwingStoreExportProvider(42), baseDir); err != nil {
+		t.Fatal(err)
+	}
+
+	artifacts := []types.SwingStoreArtifact{
+		{Name: "bundle.b1-1234", Data: []byte("bundle data")},
+		{Name: "transcript.v1.1.2", Data: []byte("changed transcript data")},
+		{Name: "snapshot.v1.42", Data: []byte("heap snapshot data")},
+		{Name: "transcript.v1.2.3", Data: []byte("new transcript data")},
+	}
+	writeLayer := func(name string, baseExportDir string) string {
+		layerDir := filepath.Join(root, name)
+		if err := os.Mkdir(layerDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		provider := newTestSwingStoreExportProvider(43)
+		remaining := artifacts
+		provider.ReadNextArtifact = func() (types.SwingStoreArtifact, error) {
+			if len(remaining) == 0 {
+				return types.SwingStoreArtifact{}, io.EOF
+			}
+			artifact := remaining[0]
+			remaining = remaining[1:]
+			return artifact, nil
+		}
+		provider.BaseExportDir = baseExportDir
+		if err := WriteSwingStoreExportToDirectory(provider, layerDir); err != nil {
+			t.Fatal(err)
+		}
+		return layerDir
+	}
+	layerFiles := func(layerDir string) []string {
+		entries, err := os.ReadDir(layerDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		return names
+	}
+	checkArtifacts := func(exportDir string) {
+		provider, err := OpenSwingStoreExportDirectory(exportDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, expected := range artifacts {
+			artifact, err := provider.ReadNextArtifact()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if artifact.Name != expected.Name || string(artifact.Data) != string(expected.Data) {
+				t.Errorf("got artifact %s %q, want %s %q", artifact.Name, artifact.Data, expected.Name, expected.Data)
+			}
+		}
+		if _, err := provider.ReadNextArtifact(); err != io.EOF {
+			t.Errorf("got %v after the last artifact, want EOF", err)
+		}
+	}
+
+	// Only the changed and new artifacts are written.
+	layer1Dir := writeLayer("layer1", baseDir)
+	if files := strings.Join(layerFiles(layer1Dir), " "); files != "1-transcript.v1.1.2 3-transcript.v1.2.3 export-data.jsonl export-manifest.json" {
+		t.Errorf("got layer files %s", files)
+	}
+	checkArtifacts(layer1Dir)
+
+	// A layer on top of a layer reads artifacts from all of them.
+	layer2Dir := writeLayer("layer2", layer1Dir)
+	if files := strings.Join(layerFiles(layer2Dir), " "); files != "export-data.jsonl export-manifest.json" {
+		t.Errorf("got layer files %s", files)
+	}
+	checkArtifacts(layer2Dir)
+
+	// Layers reference their base relative to them.
+	movedRoot := root + "-moved"
+	if err := os.Rename(root, movedRoot); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(movedRoot)
+	checkArtifacts(filepath.Join(movedRoot, "layer2"))
+
+	// A changed base is detected.
+	if err := os.WriteFile(filepath.Join(movedRoot, "base", "0-bundle.b1-1234"), []byte("other bundle"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := readTestSwingStoreExport(filepath.Join(movedRoot, "layer2")); err == nil {
+		t.Error("wanted error for changed base artifact")
+	}
+	if err := WriteSwingStoreExportToDirectory(newTestSwingStoreExportProvider(44), filepath.Join(movedRoot, "base")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenSwingStoreExportDirectory(filepath.Join(movedRoot, "layer1")); err == nil {
+		t.Error("wanted error for changed base manifest")
+	}
+}
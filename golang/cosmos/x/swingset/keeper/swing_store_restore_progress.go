@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// A restore of a swing-store export, in particular through state-sync, may
+// need to write several GB of artifacts to disk before the JS side can import
+// them. To avoid starting over if the node stops partway through, a restore
+// into a resumable directory records in a progress journal each artifact once
+// it has been written and synced to disk. When the restore is attempted again
+// at the same block height, the artifacts are still received in order, but
+// those matching a journal entry are not written again.
+
+// restoreProgressFilename is the name of the progress journal in a resumable
+// restore directory. It is a sequence of JSON lines, starting with a
+// restoreProgressHeader followed by restoreProgressEntry records.
+const restoreProgressFilename = "restore-progress.jsonl"
+
+// restoreProgressHeader identifies the restore the journal is for.
+type restoreProgressHeader struct {
+	BlockHeight uint64 `json:"blockHeight"`
+}
+
+// restoreProgressEntry records an artifact written and synced to disk.
+type restoreProgressEntry struct {
+	// Index is the position of the artifact in the export.
+	Index    int              `json:"index"`
+	Name     string           `json:"name"`
+	Filename string           `json:"filename"`
+	Digest   exportFileDigest `json:"digest"`
+}
+
+// restoreProgress is the progress journal of a resumable restore directory.
+// Its methods are safe for concurrent use.
+type restoreProgress struct {
+	exportDir string
+	mutex     sync.Mutex
+	file      *os.File
+	written   map[int]restoreProgressEntry
+}
+
+// openRestoreProgress opens the progress journal of a resumable restore
+// directory, creating the directory if needed. The content of the directory is
+// discarded if its journal is missing or for another block height.
+func openRestoreProgress(exportDir string, blockHeight uint64) (*restoreProgress, error) {
+	progress := &restoreProgress{
+		exportDir: exportDir,
+		written:   map[int]restoreProgressEntry{},
+	}
+
+	journalPath := filepath.Join(exportDir, restoreProgressFilename)
+	resumable, err := progress.load(journalPath, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	if !resumable {
+		if err := os.RemoveAll(exportDir); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.MkdirAll(exportDir, 0755); err != nil {
+		return nil, err
+	}
+
+	// Rewrite the journal with only its valid entries, in case it was
+	// truncated while appending.
+	file, err := os.OpenFile(journalPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, exportedFilesMode)
+	if err != nil {
+		return nil, err
+	}
+	encoder := json.NewEncoder(file)
+	err = encoder.Encode(restoreProgressHeader{BlockHeight: blockHeight})
+	indices := make([]int, 0, len(progress.written))
+	for index := range progress.written {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	for _, index := range indices {
+		if err != nil {
+			break
+		}
+		err = encoder.Encode(progress.written[index])
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	progress.file = file
+	return progress, nil
+}
+
+// load reads the entries of an existing journal, returning whether it is
+// for the same block height.
+func (progress *restoreProgress) load(journalPath string, blockHeight uint64) (bool, error) {
+	file, err := os.Open(journalPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	if !scanner.Scan() {
+		return false, nil
+	}
+	var header restoreProgressHeader
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil || header.BlockHeight != blockHeight {
+		return false, nil
+	}
+	for scanner.Scan() {
+		var entry restoreProgressEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// A partially appended entry ends the journal.
+			break
+		}
+		progress.written[entry.Index] = entry
+	}
+	return true, nil
+}
+
+// isWritten returns whether an artifact has already been written with the
+// same content, and is still present on disk.
+func (progress *restoreProgress) isWritten(entry restoreProgressEntry) bool {
+	progress.mutex.Lock()
+	written, ok := progress.written[entry.Index]
+	progress.mutex.Unlock()
+	if !ok || written != entry {
+		return false
+	}
+	info, err := os.Stat(filepath.Join(progress.exportDir, entry.Filename))
+	return err == nil && info.Size() == entry.Digest.Size
+}
+
+// record appends the entry of an artifact written and synced to disk.
+func (progress *restoreProgress) record(entry restoreProgressEntry) error {
+	progress.mutex.Lock()
+	defer progress.mutex.Unlock()
+
+	if err := json.NewEncoder(progress.file).Encode(entry); err != nil {
+		return err
+	}
+	if err := progress.file.Sync(); err != nil {
+		return err
+	}
+	progress.written[entry.Index] = entry
+	return nil
+}
+
+func (progress *restoreProgress) Close() error {
+	if err := progress.file.Close(); err != nil {
+		return fmt.Errorf("cannot close restore progress: %w", err)
+	}
+	return nil
+}
@@ -80,10 +80,11 @@ type AppModule struct {
 	setBootstrapNeeded       func()
 	ensureControllerInited   func(sdk.Context)
 	swingStoreExportDir      string
+	swingStoreExportOptions  GenesisSwingStoreExportOptions
 }
 
 // NewAppModule creates a new AppModule Object
-func NewAppModule(k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, setBootstrapNeeded func(), ensureControllerInited func(sdk.Context), swingStoreExportDir string) AppModule {
+func NewAppModule(k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, setBootstrapNeeded func(), ensureControllerInited func(sdk.Context), swingStoreExportDir string, swingStoreExportOptions GenesisSwingStoreExportOptions) AppModule {
 	am := AppModule{
 		AppModuleBasic:           AppModuleBasic{},
 		keeper:                   k,
@@ -91,6 +92,7 @@ func NewAppModule(k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler,
 		setBootstrapNeeded:       setBootstrapNeeded,
 		ensureControllerInited:   ensureControllerInited,
 		swingStoreExportDir:      swingStoreExportDir,
+		swingStoreExportOptions:  swingStoreExportOptions,
 	}
 	return am
 }
@@ -173,6 +175,6 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	am.checkSwingStoreExportSetup()
-	gs := ExportGenesis(ctx, am.keeper, am.swingStoreExportsHandler, am.swingStoreExportDir)
+	gs := ExportGenesis(ctx, am.keeper, am.swingStoreExportsHandler, am.swingStoreExportDir, am.swingStoreExportOptions)
 	return cdc.MustMarshalJSON(gs)
 }
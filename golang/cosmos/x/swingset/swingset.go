@@ -24,6 +24,8 @@ type swingsetMessage struct {
 
 const (
 	SwingStoreUpdateExportData = "swingStoreUpdateExportData"
+	CoreEvalOutcome            = "coreEvalOutcome"
+	BundleInstallOutcome       = "bundleInstallOutcome"
 )
 
 // NewPortHandler returns a port handler for a swingset Keeper.
@@ -46,6 +48,12 @@ func (ph portHandler) Receive(cctx context.Context, str string) (string, error)
 	case SwingStoreUpdateExportData:
 		return ph.handleSwingStoreUpdateExportData(ctx, msg.Args)
 
+	case CoreEvalOutcome:
+		return ph.handleCoreEvalOutcome(ctx, msg.Args)
+
+	case BundleInstallOutcome:
+		return ph.handleBundleInstallOutcome(ctx, msg.Args)
+
 	default:
 		return "", fmt.Errorf("unrecognized swingset method %s", msg.Method)
 	}
@@ -71,3 +79,45 @@ func (ph portHandler) handleSwingStoreUpdateExportData(ctx sdk.Context, entries
 		}
 	}
 }
+
+type coreEvalResult struct {
+	CoreEvalID uint64 `json:"coreEvalId"`
+	Success    bool   `json:"success"`
+	Error      string `json:"error"`
+}
+
+func (ph portHandler) handleCoreEvalOutcome(ctx sdk.Context, args []json.RawMessage) (ret string, err error) {
+	for _, arg := range args {
+		var result coreEvalResult
+		err = json.Unmarshal(arg, &result)
+		if err != nil {
+			return ret, err
+		}
+		err = ph.keeper.RecordCoreEvalResult(ctx, result.CoreEvalID, result.Success, result.Error)
+		if err != nil {
+			return ret, err
+		}
+	}
+	return "true", nil
+}
+
+type bundleInstallResult struct {
+	BundleID string `json:"bundleId"`
+	Accepted bool   `json:"accepted"`
+	Error    string `json:"error"`
+}
+
+func (ph portHandler) handleBundleInstallOutcome(ctx sdk.Context, args []json.RawMessage) (ret string, err error) {
+	for _, arg := range args {
+		var result bundleInstallResult
+		err = json.Unmarshal(arg, &result)
+		if err != nil {
+			return ret, err
+		}
+		err = ph.keeper.RecordBundleInstallOutcome(ctx, result.BundleID, result.Accepted, result.Error)
+		if err != nil {
+			return ret, err
+		}
+	}
+	return "true", nil
+}
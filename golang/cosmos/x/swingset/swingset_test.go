@@ -0,0 +1,99 @@
+package swingset
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+func makeTestKeeper(t *testing.T) (Keeper, sdk.Context) {
+	swingsetStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+	vstorageStoreKey := storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramSpace := paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey, types.ModuleName)
+	k := NewKeeper(cdc, swingsetStoreKey, paramSpace, nil, nil, nil,
+		vstoragekeeper.NewKeeper(vstorageStoreKey), authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress("gov").String(), nil)
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 10}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
+	return k, ctx
+}
+
+func TestPortHandlerCoreEvalOutcome(t *testing.T) {
+	k, ctx := makeTestKeeper(t)
+	ph := NewPortHandler(k)
+	eval := types.CoreEval{JsonPermits: "true", JsCode: "() => {}"}
+
+	if err := k.CoreEval(ctx, types.NewMsgCoreEval(k.GetAuthority(), []types.CoreEval{eval}, nil)); err != nil {
+		t.Fatal(err)
+	}
+	k.Hooks().AfterProposalVotingPeriodEnded(ctx, 3)
+
+	goCtx := sdk.WrapSDKContext(ctx)
+	ret, err := ph.Receive(goCtx, `{"method":"coreEvalOutcome","args":[{"coreEvalId":1,"success":false,"error":"boom"}]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret != "true" {
+		t.Errorf("got %q, want true", ret)
+	}
+	outcome, found := k.GetCoreEvalOutcome(ctx, 3)
+	if !found || outcome.Pending != 0 || outcome.Success || outcome.Error != "boom" {
+		t.Errorf("got outcome %v, found %t, want failure", outcome, found)
+	}
+
+	if _, err := ph.Receive(goCtx, `{"method":"coreEvalOutcome","args":[{"coreEvalId":2,"success":true}]}`); err == nil {
+		t.Errorf("wanted error for an unknown core eval")
+	}
+	if _, err := ph.Receive(goCtx, `{"method":"unknown","args":[]}`); err == nil {
+		t.Errorf("wanted error for an unknown method")
+	}
+}
+
+func TestPortHandlerBundleInstallOutcome(t *testing.T) {
+	k, ctx := makeTestKeeper(t)
+	ph := NewPortHandler(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	k.RecordBundleInstall(ctx, types.NewMsgInstallBundle(`{"moduleFormat":"endoZipBase64","endoZipBase64Sha512":"abc123","endoZipBase64":""}`, nil))
+	ret, err := ph.Receive(goCtx, `{"method":"bundleInstallOutcome","args":[{"bundleId":"b1-abc123","accepted":true,"error":""}]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret != "true" {
+		t.Errorf("got %q, want true", ret)
+	}
+	if !k.IsBundleAccepted(ctx, "b1-abc123") {
+		t.Errorf("wanted accepted bundle")
+	}
+
+	if _, err := ph.Receive(goCtx, `{"method":"bundleInstallOutcome","args":[{"bundleId":"b1-def456","accepted":true}]}`); err == nil {
+		t.Errorf("wanted error for an unknown bundle")
+	}
+}
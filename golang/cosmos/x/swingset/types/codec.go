@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -31,8 +32,18 @@ func init() {
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeliverInbound{}, ModuleName+"/DeliverInbound", nil)
 	cdc.RegisterConcrete(&MsgProvision{}, ModuleName+"/Provision", nil)
+	cdc.RegisterConcrete(&MsgUpdateEgressPowerFlags{}, ModuleName+"/UpdateEgressPowerFlags", nil)
+	cdc.RegisterConcrete(&MsgDeprovision{}, ModuleName+"/Deprovision", nil)
 	cdc.RegisterConcrete(&MsgWalletAction{}, ModuleName+"/WalletAction", nil)
 	cdc.RegisterConcrete(&MsgWalletSpendAction{}, ModuleName+"/WalletSpendAction", nil)
+	cdc.RegisterConcrete(&MsgWalletOffer{}, ModuleName+"/WalletOffer", nil)
+	cdc.RegisterConcrete(&MsgWalletActionBatch{}, ModuleName+"/WalletActionBatch", nil)
+	cdc.RegisterConcrete(&MsgCoreEval{}, ModuleName+"/CoreEval", nil)
+	cdc.RegisterConcrete(&MsgBeginBundleUpload{}, ModuleName+"/BeginBundleUpload", nil)
+	cdc.RegisterConcrete(&MsgUploadBundleChunk{}, ModuleName+"/UploadBundleChunk", nil)
+	cdc.RegisterConcrete(&MsgFinishBundleUpload{}, ModuleName+"/FinishBundleUpload", nil)
+	cdc.RegisterConcrete(&MsgSetInboundPause{}, ModuleName+"/SetInboundPause", nil)
+	cdc.RegisterConcrete(&MsgPurgeInboundQueue{}, ModuleName+"/PurgeInboundQueue", nil)
 }
 
 // RegisterInterfaces registers the x/swingset interfaces types with the interface registry
@@ -40,8 +51,22 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDeliverInbound{},
 		&MsgProvision{},
+		&MsgUpdateEgressPowerFlags{},
+		&MsgDeprovision{},
 		&MsgWalletAction{},
 		&MsgWalletSpendAction{},
+		&MsgWalletOffer{},
+		&MsgWalletActionBatch{},
+		&MsgCoreEval{},
+		&MsgBeginBundleUpload{},
+		&MsgUploadBundleChunk{},
+		&MsgFinishBundleUpload{},
+		&MsgSetInboundPause{},
+		&MsgPurgeInboundQueue{},
+	)
+	registry.RegisterImplementations(
+		(*tx.TxExtensionOptionI)(nil),
+		&ExtensionOptionFeeDenom{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -21,11 +21,23 @@ const (
 	BeansPerVatCreation          = "vatCreation"
 	BeansPerXsnapComputron       = "xsnapComputron"
 	BeansPerSmartWalletProvision = "smartWalletProvision"
+	BeansPerBundleChunk          = "bundleChunk"
 
 	// QueueSize keys.
 	// Keep up-to-date with updateQueueAllowed() in packanges/cosmic-swingset/src/launch-chain.js
 	QueueInbound        = "inbound"
 	QueueInboundMempool = "inbound_mempool"
+	// QueueInboundPerTx is the maximum number of inbound queue messages
+	// allowed in a single transaction.
+	QueueInboundPerTx = "inbound_per_tx"
+	// QueueInboundPerSender is the maximum number of actions from a single
+	// sender allowed in the inbound queue. It is unlimited if not configured.
+	QueueInboundPerSender = "inbound_per_sender"
+	// QueueInboundPerSenderMsgTypePrefix followed by a message type URL is the
+	// maximum number of actions of that message type from a single sender
+	// allowed in the inbound queue, e.g.
+	// "inbound_per_sender:/agoric.swingset.MsgWalletSpendAction".
+	QueueInboundPerSenderMsgTypePrefix = QueueInboundPerSender + ":"
 
 	// PowerFlags.
 	PowerFlagSmartWallet = "SMART_WALLET"
@@ -54,6 +66,7 @@ var (
 	DefaultBeansPerMinFeeDebit          = DefaultBeansPerFeeUnit.Quo(sdk.NewUint(5))      // $0.2
 	DefaultBeansPerStorageByte          = DefaultBeansPerFeeUnit.Quo(sdk.NewUint(500))    // $0.002
 	DefaultBeansPerSmartWalletProvision = DefaultBeansPerFeeUnit                          // $1
+	DefaultBeansPerBundleChunk          = DefaultBeansPerFeeUnit.Quo(sdk.NewUint(1_000))  // $0.001
 
 	DefaultBootstrapVatConfig = "@agoric/vm-config/decentral-core-config.json"
 
@@ -62,9 +75,11 @@ var (
 	}
 
 	DefaultInboundQueueMax = int32(1_000)
+	DefaultInboundPerTxMax = int32(1)
 
 	DefaultQueueMax = []QueueSize{
 		NewQueueSize(QueueInbound, DefaultInboundQueueMax),
+		NewQueueSize(QueueInboundPerTx, DefaultInboundPerTxMax),
 	}
 )
 
@@ -81,5 +96,6 @@ func DefaultBeansPerUnit() []StringBeans {
 		NewStringBeans(BeansPerVatCreation, DefaultBeansPerVatCreation),
 		NewStringBeans(BeansPerXsnapComputron, DefaultBeansPerXsnapComputron),
 		NewStringBeans(BeansPerSmartWalletProvision, DefaultBeansPerSmartWalletProvision),
+		NewStringBeans(BeansPerBundleChunk, DefaultBeansPerBundleChunk),
 	}
 }
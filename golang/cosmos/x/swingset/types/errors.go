@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+)
+
+// x/swingset module sentinel errors
+var (
+	ErrInboundSenderLimit     = sdkioerrors.Register(ModuleName, 2, "inbound queue limit for sender reached")
+	ErrInboundPaused          = sdkioerrors.Register(ModuleName, 3, "inbound messages paused by governance")
+	ErrBundleAlreadyInstalled = sdkioerrors.Register(ModuleName, 4, "bundle already installed")
+)
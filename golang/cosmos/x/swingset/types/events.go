@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	EventTypeGrantedBeanCharge = "granted_bean_charge"
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+)
+
+// NewGrantedBeanChargeEvent returns an event attributing a bean charge debited
+// from a feegrant allowance to both the granter and the grantee.
+func NewGrantedBeanChargeEvent(granter, grantee sdk.AccAddress, amount sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeGrantedBeanCharge,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyGranter, granter.String()),
+		sdk.NewAttribute(AttributeKeyGrantee, grantee.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
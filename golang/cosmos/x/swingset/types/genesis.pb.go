@@ -29,6 +29,9 @@ type GenesisState struct {
 	State                    State                        `protobuf:"bytes,3,opt,name=state,proto3" json:"state"`
 	SwingStoreExportData     []*SwingStoreExportDataEntry `protobuf:"bytes,4,rep,name=swing_store_export_data,json=swingStoreExportData,proto3" json:"swingStoreExportData"`
 	SwingStoreExportDataHash string                       `protobuf:"bytes,5,opt,name=swing_store_export_data_hash,json=swingStoreExportDataHash,proto3" json:"swingStoreExportDataHash"`
+	// The senders of the actions in the actionQueue, which are subject to the
+	// per-sender inbound limits.
+	InboundQueueSenders []InboundQueueSender `protobuf:"bytes,6,rep,name=inbound_queue_senders,json=inboundQueueSenders,proto3" json:"inboundQueueSenders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +95,74 @@ func (m *GenesisState) GetSwingStoreExportDataHash() string {
 	return ""
 }
 
+func (m *GenesisState) GetInboundQueueSenders() []InboundQueueSender {
+	if m != nil {
+		return m.InboundQueueSenders
+	}
+	return nil
+}
+
+// The sender of the action at an index of the actionQueue.
+type InboundQueueSender struct {
+	Index   uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Sender  string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	MsgType string `protobuf:"bytes,3,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+}
+
+func (m *InboundQueueSender) Reset()         { *m = InboundQueueSender{} }
+func (m *InboundQueueSender) String() string { return proto.CompactTextString(m) }
+func (*InboundQueueSender) ProtoMessage()    {}
+func (*InboundQueueSender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49b057311de9d296, []int{1}
+}
+func (m *InboundQueueSender) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundQueueSender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundQueueSender.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InboundQueueSender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundQueueSender.Merge(m, src)
+}
+func (m *InboundQueueSender) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundQueueSender) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundQueueSender.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InboundQueueSender proto.InternalMessageInfo
+
+func (m *InboundQueueSender) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *InboundQueueSender) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *InboundQueueSender) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
 // A SwingStore "export data" entry.
 type SwingStoreExportDataEntry struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -102,7 +173,7 @@ func (m *SwingStoreExportDataEntry) Reset()         { *m = SwingStoreExportDataE
 func (m *SwingStoreExportDataEntry) String() string { return proto.CompactTextString(m) }
 func (*SwingStoreExportDataEntry) ProtoMessage()    {}
 func (*SwingStoreExportDataEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49b057311de9d296, []int{1}
+	return fileDescriptor_49b057311de9d296, []int{2}
 }
 func (m *SwingStoreExportDataEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,36 +218,43 @@ func (m *SwingStoreExportDataEntry) GetValue() string {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "agoric.swingset.GenesisState")
+	proto.RegisterType((*InboundQueueSender)(nil), "agoric.swingset.InboundQueueSender")
 	proto.RegisterType((*SwingStoreExportDataEntry)(nil), "agoric.swingset.SwingStoreExportDataEntry")
 }
 
 func init() { proto.RegisterFile("agoric/swingset/genesis.proto", fileDescriptor_49b057311de9d296) }
 
 var fileDescriptor_49b057311de9d296 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x4a, 0xc3, 0x40,
-	0x14, 0x85, 0x93, 0xfe, 0x41, 0xa7, 0x82, 0x12, 0x8a, 0x8d, 0xa5, 0x26, 0xa5, 0xab, 0x22, 0x98,
-	0x40, 0xc5, 0x8d, 0xae, 0x8c, 0x16, 0x5d, 0x4a, 0x8a, 0x1b, 0x11, 0xc2, 0xb4, 0x1d, 0x26, 0xa1,
-	0x6d, 0x26, 0xe4, 0x4e, 0xb5, 0xc5, 0x97, 0xf0, 0x11, 0x7c, 0x9c, 0x2e, 0xbb, 0x14, 0x17, 0x45,
-	0xda, 0x8d, 0xf4, 0x29, 0x24, 0x33, 0x2d, 0x42, 0x7f, 0x76, 0x27, 0xf9, 0xce, 0x39, 0x37, 0xdc,
-	0x1b, 0x74, 0x8a, 0x29, 0x8b, 0x83, 0x8e, 0x0d, 0x6f, 0x41, 0x48, 0x81, 0x70, 0x9b, 0x92, 0x90,
-	0x40, 0x00, 0x56, 0x14, 0x33, 0xce, 0xb4, 0x43, 0x89, 0xad, 0x35, 0x2e, 0x17, 0x29, 0xa3, 0x4c,
-	0x30, 0x3b, 0x51, 0xd2, 0x56, 0x36, 0x36, 0x5b, 0xd6, 0x42, 0xf2, 0xda, 0x77, 0x0a, 0x1d, 0xdc,
-	0xcb, 0xe2, 0x16, 0xc7, 0x9c, 0x68, 0x97, 0x28, 0x17, 0xe1, 0x18, 0x0f, 0x40, 0x4f, 0x55, 0xd5,
-	0x7a, 0xa1, 0x51, 0xb2, 0x36, 0x06, 0x59, 0x8f, 0x02, 0x3b, 0x99, 0xc9, 0xcc, 0x54, 0xdc, 0x95,
-	0x59, 0x6b, 0xa0, 0x2c, 0x24, 0x79, 0x3d, 0x2d, 0x52, 0xc7, 0x5b, 0x29, 0xd1, 0xbe, 0x0a, 0x49,
-	0xab, 0xf6, 0x8e, 0x4a, 0x02, 0x7b, 0xc0, 0x59, 0x4c, 0x3c, 0x32, 0x8a, 0x58, 0xcc, 0xbd, 0x2e,
-	0xe6, 0x58, 0xcf, 0x54, 0xd3, 0xf5, 0x42, 0xe3, 0x6c, 0xbb, 0x25, 0x11, 0xad, 0xc4, 0xde, 0x14,
-	0xee, 0x3b, 0xcc, 0x71, 0x33, 0xe4, 0xf1, 0xd8, 0xd1, 0x97, 0x33, 0xb3, 0x08, 0x3b, 0xb0, 0xbb,
-	0xf3, 0xad, 0xf6, 0x82, 0x2a, 0x7b, 0x86, 0x7b, 0x3e, 0x06, 0x5f, 0xcf, 0x56, 0xd5, 0x7a, 0xde,
-	0xa9, 0x2c, 0x67, 0xa6, 0xbe, 0x2b, 0xff, 0x80, 0xc1, 0x77, 0xf7, 0x92, 0xab, 0xcc, 0xef, 0xa7,
-	0xa9, 0xd4, 0x6e, 0xd1, 0xc9, 0xde, 0x0f, 0xd6, 0x8e, 0x50, 0xba, 0x47, 0xc6, 0xba, 0x9a, 0xcc,
-	0x71, 0x13, 0xa9, 0x15, 0x51, 0xf6, 0x15, 0xf7, 0x87, 0x44, 0x6c, 0x3e, 0xef, 0xca, 0x07, 0xe7,
-	0x69, 0x32, 0x37, 0xd4, 0xe9, 0xdc, 0x50, 0x7f, 0xe6, 0x86, 0xfa, 0xb1, 0x30, 0x94, 0xe9, 0xc2,
-	0x50, 0xbe, 0x16, 0x86, 0xf2, 0x7c, 0x4d, 0x03, 0xee, 0x0f, 0xdb, 0x56, 0x87, 0x0d, 0xec, 0x1b,
-	0x79, 0x66, 0xb9, 0xaf, 0x73, 0xe8, 0xf6, 0x6c, 0xca, 0xfa, 0x38, 0xa4, 0x76, 0x87, 0xc1, 0x80,
-	0x81, 0x3d, 0xfa, 0xff, 0x03, 0xf8, 0x38, 0x22, 0xd0, 0xce, 0x89, 0xfb, 0x5f, 0xfc, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x1b, 0x95, 0x41, 0xea, 0x67, 0x02, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x4f, 0xe8, 0x1f, 0xa8, 0x87, 0x04, 0x32, 0x65, 0xcb, 0xc6, 0x48, 0xaa, 0x72, 0xa9, 0x90,
+	0x48, 0xa4, 0x22, 0x2e, 0x70, 0x22, 0x30, 0x01, 0x37, 0x48, 0xe1, 0x82, 0x40, 0x91, 0xdb, 0x58,
+	0x6e, 0xb4, 0xc5, 0x0e, 0x79, 0x0e, 0xb4, 0xe2, 0x4b, 0xf0, 0x11, 0xf8, 0x38, 0x3b, 0xf6, 0xc8,
+	0xa9, 0x42, 0xed, 0x05, 0xf5, 0x53, 0x20, 0xdb, 0x9d, 0x26, 0x35, 0xe9, 0xed, 0x3d, 0xff, 0xfe,
+	0xd9, 0x7e, 0x0f, 0x3d, 0x24, 0x4c, 0x14, 0xe9, 0x24, 0x80, 0x1f, 0x29, 0x67, 0x40, 0x65, 0xc0,
+	0x28, 0xa7, 0x90, 0x82, 0x9f, 0x17, 0x42, 0x0a, 0x7c, 0xc7, 0xc0, 0xfe, 0x15, 0x7c, 0xd2, 0x65,
+	0x82, 0x09, 0x8d, 0x05, 0xaa, 0x32, 0xb4, 0x13, 0x77, 0xd7, 0xe5, 0xaa, 0x30, 0x78, 0x7f, 0xd1,
+	0x40, 0xb7, 0xdf, 0x18, 0xe3, 0x91, 0x24, 0x92, 0xe2, 0x67, 0xa8, 0x9d, 0x93, 0x82, 0x64, 0xe0,
+	0xdc, 0xe8, 0xd9, 0x83, 0x83, 0xe1, 0x91, 0xbf, 0x13, 0xe4, 0xbf, 0xd7, 0x70, 0xd8, 0xbc, 0x5c,
+	0x7a, 0x56, 0xb4, 0x25, 0xe3, 0x21, 0x6a, 0x81, 0xd2, 0x3b, 0x0d, 0xad, 0x3a, 0xac, 0xa8, 0xb4,
+	0xfb, 0x56, 0x64, 0xa8, 0xf8, 0x27, 0x3a, 0xd2, 0x70, 0x0c, 0x52, 0x14, 0x34, 0xa6, 0xb3, 0x5c,
+	0x14, 0x32, 0x4e, 0x88, 0x24, 0x4e, 0xb3, 0xd7, 0x18, 0x1c, 0x0c, 0x1f, 0x57, 0x5d, 0x54, 0x31,
+	0x52, 0xf4, 0x33, 0xcd, 0x7e, 0x4d, 0x24, 0x39, 0xe3, 0xb2, 0x98, 0x87, 0xce, 0x66, 0xe9, 0x75,
+	0xa1, 0x06, 0x8e, 0x6a, 0x4f, 0xf1, 0x17, 0x74, 0xba, 0x27, 0x3c, 0x9e, 0x12, 0x98, 0x3a, 0xad,
+	0x9e, 0x3d, 0xe8, 0x84, 0xa7, 0x9b, 0xa5, 0xe7, 0xd4, 0xe9, 0xdf, 0x12, 0x98, 0x46, 0x7b, 0x11,
+	0x2c, 0xd1, 0xfd, 0x94, 0x8f, 0x45, 0xc9, 0x93, 0xf8, 0x5b, 0x49, 0x4b, 0x1a, 0x03, 0xe5, 0x09,
+	0x2d, 0xc0, 0x69, 0xeb, 0x87, 0x3d, 0xaa, 0x3c, 0xec, 0x9d, 0x61, 0x7f, 0x50, 0xe4, 0x91, 0xe6,
+	0x86, 0x0f, 0xd4, 0x5f, 0x6d, 0x96, 0xde, 0xbd, 0xb4, 0x82, 0x41, 0x54, 0x77, 0xf8, 0xbc, 0xf9,
+	0xef, 0xb7, 0x67, 0xf5, 0xbf, 0x22, 0x5c, 0x75, 0xc3, 0x5d, 0xd4, 0x4a, 0x79, 0x42, 0x67, 0x8e,
+	0xdd, 0xb3, 0x07, 0xcd, 0xc8, 0x34, 0xf8, 0x10, 0xb5, 0xcd, 0xcd, 0xf4, 0xb4, 0x3b, 0xd1, 0xb6,
+	0xc3, 0xc7, 0xe8, 0x56, 0x06, 0x2c, 0x96, 0xf3, 0xdc, 0x4c, 0xb4, 0x13, 0xdd, 0xcc, 0x80, 0x7d,
+	0x9c, 0xe7, 0xb4, 0xff, 0x0a, 0x1d, 0xef, 0x9d, 0x02, 0xbe, 0x8b, 0x1a, 0xe7, 0x74, 0xae, 0x33,
+	0x3a, 0x91, 0x2a, 0x55, 0xee, 0x77, 0x72, 0x51, 0xd2, 0x6d, 0x80, 0x69, 0xc2, 0x4f, 0x97, 0x2b,
+	0xd7, 0x5e, 0xac, 0x5c, 0xfb, 0xef, 0xca, 0xb5, 0x7f, 0xad, 0x5d, 0x6b, 0xb1, 0x76, 0xad, 0x3f,
+	0x6b, 0xd7, 0xfa, 0xfc, 0x82, 0xa5, 0x72, 0x5a, 0x8e, 0xfd, 0x89, 0xc8, 0x82, 0x97, 0x66, 0x77,
+	0xcd, 0x5f, 0x3d, 0x81, 0xe4, 0x3c, 0x60, 0xe2, 0x82, 0x70, 0x16, 0x4c, 0x04, 0x64, 0x02, 0x82,
+	0xd9, 0xf5, 0x5a, 0xab, 0x9b, 0xc2, 0xb8, 0xad, 0x97, 0xfa, 0xe9, 0xff, 0x01, 0x00, 0xdb, 0xfb,
+	0x90, 0x67, 0x3c, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -199,6 +277,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InboundQueueSenders) > 0 {
+		for iNdEx := len(m.InboundQueueSenders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundQueueSenders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SwingStoreExportDataHash) > 0 {
 		i -= len(m.SwingStoreExportDataHash)
 		copy(dAtA[i:], m.SwingStoreExportDataHash)
@@ -243,6 +335,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InboundQueueSender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundQueueSender) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundQueueSender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SwingStoreExportDataEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -311,6 +445,32 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.InboundQueueSenders) > 0 {
+		for _, e := range m.InboundQueueSenders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InboundQueueSender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -498,6 +658,173 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.SwingStoreExportDataHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundQueueSenders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundQueueSenders = append(m.InboundQueueSenders, InboundQueueSender{})
+			if err := m.InboundQueueSenders[len(m.InboundQueueSenders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InboundQueueSender) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundQueueSender: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundQueueSender: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,14 @@ package types
 import (
 	"bytes"
 	"compress/gzip"
+	"crypto/sha512"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"strings"
 
 	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,15 +21,30 @@ const RouterKey = ModuleName // this was defined in your key.go file
 var (
 	_ sdk.Msg = &MsgDeliverInbound{}
 	_ sdk.Msg = &MsgProvision{}
+	_ sdk.Msg = &MsgUpdateEgressPowerFlags{}
+	_ sdk.Msg = &MsgDeprovision{}
 	_ sdk.Msg = &MsgInstallBundle{}
 	_ sdk.Msg = &MsgWalletAction{}
 	_ sdk.Msg = &MsgWalletSpendAction{}
+	_ sdk.Msg = &MsgWalletActionBatch{}
+	_ sdk.Msg = &MsgCoreEval{}
+	_ sdk.Msg = &MsgBeginBundleUpload{}
+	_ sdk.Msg = &MsgUploadBundleChunk{}
+	_ sdk.Msg = &MsgFinishBundleUpload{}
+	_ sdk.Msg = &MsgSetInboundPause{}
+	_ sdk.Msg = &MsgPurgeInboundQueue{}
 
 	_ vm.ControllerAdmissionMsg = &MsgDeliverInbound{}
 	_ vm.ControllerAdmissionMsg = &MsgInstallBundle{}
 	_ vm.ControllerAdmissionMsg = &MsgProvision{}
+	_ vm.ControllerAdmissionMsg = &MsgUpdateEgressPowerFlags{}
+	_ vm.ControllerAdmissionMsg = &MsgDeprovision{}
 	_ vm.ControllerAdmissionMsg = &MsgWalletAction{}
 	_ vm.ControllerAdmissionMsg = &MsgWalletSpendAction{}
+	_ vm.ControllerAdmissionMsg = &MsgWalletActionBatch{}
+	_ vm.ControllerAdmissionMsg = &MsgBeginBundleUpload{}
+	_ vm.ControllerAdmissionMsg = &MsgUploadBundleChunk{}
+	_ vm.ControllerAdmissionMsg = &MsgFinishBundleUpload{}
 )
 
 // Contextual information about the message source of an action on an inbound queue.
@@ -52,6 +70,28 @@ const (
 	// bundleUncompressedSizeLimit is the (exclusive) limit on uncompressed bundle size.
 	// We must ensure there is an exclusive int64 limit in order to detect an underflow.
 	bundleUncompressedSizeLimit int64 = 10 * 1024 * 1024 // 10MB
+
+	// BundleUploadSizeLimit is the (exclusive) limit on the compressed and
+	// uncompressed sizes of a bundle uploaded in chunks.
+	BundleUploadSizeLimit int64 = 100 * 1024 * 1024 // 100MB
+
+	// bundleHashLength is the length of a hex-encoded SHA-512 bundle hash.
+	bundleHashLength = 2 * sha512.Size
+
+	// deprovisionReasonLengthLimit is the (inclusive) limit on the length of
+	// the reason of a MsgDeprovision.
+	deprovisionReasonLengthLimit = 256
+
+	// WalletActionBatchSizeLimit is the (inclusive) limit on the number of
+	// actions of a MsgWalletActionBatch, which takes a single entry of the
+	// inbound queue however many actions it has.
+	WalletActionBatchSizeLimit = 32
+)
+
+// The inbound queues, named by their vstorage paths.
+const (
+	InboundQueueAction       = "actionQueue"
+	InboundQueueHighPriority = "highPriorityQueue"
 )
 
 // Charge an account address for the beans associated with given messages and storage.
@@ -68,6 +108,46 @@ func chargeAdmission(ctx sdk.Context, keeper SwingSetKeeper, addr sdk.AccAddress
 	return keeper.ChargeBeans(ctx, addr, beans)
 }
 
+// admissionMsgCounter is implemented by messages which are charged on
+// admission for a different number of messages than their inbound queue
+// entries, like MsgWalletActionBatch.
+type admissionMsgCounter interface {
+	GetAdmissionMsgCount() int
+}
+
+// admissionStorageSizer is implemented by messages which are charged on
+// admission for the storage of their contents, like MsgInstallBundle.
+type admissionStorageSizer interface {
+	GetAdmissionStorageSize() uint64
+}
+
+// EstimateAdmissionBeans returns the beans a message is expected to be charged
+// on admission.
+func EstimateAdmissionBeans(beansPerUnit map[string]sdkmath.Uint, msg vm.ControllerAdmissionMsg) sdkmath.Uint {
+	beans := sdkmath.ZeroUint()
+	if b, ok := beansPerUnit[BeansPerInboundTx]; ok {
+		beans = beans.Add(b)
+	}
+	if b, ok := beansPerUnit[BeansPerMessage]; ok {
+		count := uint64(msg.GetInboundMsgCount())
+		if counter, ok := msg.(admissionMsgCounter); ok {
+			count = uint64(counter.GetAdmissionMsgCount())
+		}
+		beans = beans.Add(b.MulUint64(count))
+	}
+	if sized, ok := msg.(interface{ Size() int }); ok {
+		if b, ok := beansPerUnit[BeansPerMessageByte]; ok {
+			beans = beans.Add(b.MulUint64(uint64(sized.Size())))
+		}
+	}
+	if sizer, ok := msg.(admissionStorageSizer); ok {
+		if b, ok := beansPerUnit[BeansPerStorageByte]; ok {
+			beans = beans.Add(b.MulUint64(sizer.GetAdmissionStorageSize()))
+		}
+	}
+	return beans
+}
+
 // checkSmartWalletProvisioned verifies if a smart wallet message (MsgWalletAction
 // and MsgWalletSpendAction) can be delivered for the owner's address. A message
 // is allowed if a smart wallet is already provisioned for the address, or if the
@@ -297,6 +377,102 @@ func (msg MsgWalletSpendAction) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgWalletActionBatch(owner sdk.AccAddress, actions []WalletBatchAction) *MsgWalletActionBatch {
+	return &MsgWalletActionBatch{
+		Owner:   owner,
+		Actions: actions,
+	}
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgWalletActionBatch) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+
+	err := checkSmartWalletProvisioned(ctx, keeper, msg.Owner)
+	if err != nil {
+		return err
+	}
+
+	actions := make([]string, len(msg.Actions))
+	for i, a := range msg.Actions {
+		actions[i] = a.Action
+	}
+	return chargeAdmission(ctx, keeper, msg.Owner, actions, 0)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+// The whole batch is delivered as a single action, and so takes a single entry
+// of the inbound queue.
+func (msg MsgWalletActionBatch) GetInboundMsgCount() int32 {
+	return 1
+}
+
+// GetAdmissionMsgCount returns the number of messages the batch is charged for
+// on admission, which is its number of actions.
+func (msg MsgWalletActionBatch) GetAdmissionMsgCount() int {
+	return len(msg.Actions)
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+// Like MsgWalletSpendAction, a batch is high priority for a high priority
+// owner, but only if all its actions are spend actions.
+func (msg MsgWalletActionBatch) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return false, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+
+	for _, a := range msg.Actions {
+		if !a.Spend {
+			return false, nil
+		}
+	}
+	return keeper.IsHighPriorityAddress(ctx, msg.Owner)
+}
+
+func (msg MsgWalletActionBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgWalletActionBatch) GetSignBytes() []byte {
+	if msg.Actions == nil {
+		msg.Actions = []WalletBatchAction{}
+	}
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// Route should return the name of the module
+func (msg MsgWalletActionBatch) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgWalletActionBatch) Type() string { return "wallet_action_batch" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWalletActionBatch) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Owner address cannot be empty")
+	}
+	if len(msg.Actions) == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Actions cannot be empty")
+	}
+	if len(msg.Actions) > WalletActionBatchSizeLimit {
+		return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Actions cannot be more than %d", WalletActionBatchSizeLimit)
+	}
+	for i, a := range msg.Actions {
+		if len(strings.TrimSpace(a.Action)) == 0 {
+			return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Action %d cannot be empty", i)
+		}
+		if !json.Valid([]byte(a.Action)) {
+			return sdkioerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "Wallet action %d must be valid JSON", i)
+		}
+	}
+	return nil
+}
+
 func NewMsgProvision(nickname string, addr sdk.AccAddress, powerFlags []string, submitter sdk.AccAddress) *MsgProvision {
 	return &MsgProvision{
 		Nickname:   nickname,
@@ -360,6 +536,147 @@ func (msg MsgProvision) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Submitter}
 }
 
+func NewMsgUpdateEgressPowerFlags(addr sdk.AccAddress, addPowerFlags, removePowerFlags []string, submitter sdk.AccAddress) *MsgUpdateEgressPowerFlags {
+	return &MsgUpdateEgressPowerFlags{
+		Address:          addr,
+		AddPowerFlags:    addPowerFlags,
+		RemovePowerFlags: removePowerFlags,
+		Submitter:        submitter,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgUpdateEgressPowerFlags) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUpdateEgressPowerFlags) Type() string { return "update_egress_power_flags" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateEgressPowerFlags) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if msg.Address.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Peer address cannot be empty")
+	}
+	if len(msg.AddPowerFlags) == 0 && len(msg.RemovePowerFlags) == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Must add or remove power flags")
+	}
+	added := make(map[string]bool, len(msg.AddPowerFlags))
+	for _, powerFlag := range msg.AddPowerFlags {
+		if len(powerFlag) == 0 {
+			return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Power flags cannot be empty")
+		}
+		added[powerFlag] = true
+	}
+	for _, powerFlag := range msg.RemovePowerFlags {
+		if len(powerFlag) == 0 {
+			return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Power flags cannot be empty")
+		}
+		if added[powerFlag] {
+			return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Power flag %s cannot be both added and removed", powerFlag)
+		}
+	}
+	return nil
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+// The power flag fees are charged by the msg server, but the message is also
+// charged for its delivery to the controller.
+func (msg MsgUpdateEgressPowerFlags) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+
+	powerFlags := strings.Join(append(append([]string{}, msg.AddPowerFlags...), msg.RemovePowerFlags...), ",")
+	return chargeAdmission(ctx, keeper, msg.Submitter, []string{powerFlags}, 0)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgUpdateEgressPowerFlags) GetInboundMsgCount() int32 {
+	return 1
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgUpdateEgressPowerFlags) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateEgressPowerFlags) GetSignBytes() []byte {
+	if msg.AddPowerFlags == nil {
+		msg.AddPowerFlags = []string{}
+	}
+	if msg.RemovePowerFlags == nil {
+		msg.RemovePowerFlags = []string{}
+	}
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateEgressPowerFlags) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+func NewMsgDeprovision(addr sdk.AccAddress, reason string, submitter sdk.AccAddress) *MsgDeprovision {
+	return &MsgDeprovision{
+		Address:   addr,
+		Reason:    reason,
+		Submitter: submitter,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDeprovision) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDeprovision) Type() string { return "deprovision" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDeprovision) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if msg.Address.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Peer address cannot be empty")
+	}
+	if len(msg.Reason) > deprovisionReasonLengthLimit {
+		return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Reason cannot be longer than %d bytes", deprovisionReasonLengthLimit)
+	}
+	return nil
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgDeprovision) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+
+	return chargeAdmission(ctx, keeper, msg.Submitter, []string{msg.Reason}, 0)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgDeprovision) GetInboundMsgCount() int32 {
+	return 1
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgDeprovision) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeprovision) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeprovision) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
 func NewMsgInstallBundle(bundleJson string, submitter sdk.AccAddress) *MsgInstallBundle {
 	return &MsgInstallBundle{
 		Bundle:    bundleJson,
@@ -373,7 +690,13 @@ func (msg MsgInstallBundle) CheckAdmissibility(ctx sdk.Context, data interface{}
 	if !ok {
 		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
 	}
-	return chargeAdmission(ctx, keeper, msg.Submitter, []string{msg.Bundle}, msg.ExpectedUncompressedSize())
+	return chargeAdmission(ctx, keeper, msg.Submitter, []string{msg.Bundle}, msg.GetAdmissionStorageSize())
+}
+
+// GetAdmissionStorageSize returns the number of storage bytes charged on
+// admission, which is the uncompressed size of the bundle.
+func (msg MsgInstallBundle) GetAdmissionStorageSize() uint64 {
+	return msg.ExpectedUncompressedSize()
 }
 
 // GetInboundMsgCount implements InboundMsgCarrier.
@@ -481,3 +804,403 @@ func (msg *MsgInstallBundle) Uncompress() error {
 	msg.UncompressedSize = 0
 	return nil
 }
+
+func NewMsgCoreEval(authority string, evals []CoreEval, bundles []CoreEvalBundle) *MsgCoreEval {
+	return &MsgCoreEval{
+		Authority: authority,
+		Evals:     evals,
+		Bundles:   bundles,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCoreEval) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCoreEval) Type() string { return "coreEval" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCoreEval) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if len(msg.Evals) == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "no core evals provided")
+	}
+	for i, eval := range msg.Evals {
+		if err := eval.ValidateBasic(); err != nil {
+			return sdkioerrors.Wrapf(err, "invalid core eval %d", i)
+		}
+	}
+	for i, bundle := range msg.Bundles {
+		if err := bundle.ValidateBasic(); err != nil {
+			return sdkioerrors.Wrapf(err, "invalid bundle %d", i)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCoreEval) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCoreEval) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// InstallBundleMsg returns a MsgInstallBundle for the attached bundle,
+// submitted by the given address.
+func (bundle CoreEvalBundle) InstallBundleMsg(submitter sdk.AccAddress) *MsgInstallBundle {
+	return &MsgInstallBundle{
+		Submitter:        submitter,
+		CompressedBundle: bundle.CompressedBundle,
+		UncompressedSize: bundle.UncompressedSize,
+	}
+}
+
+// ValidateBasic runs stateless checks on the attached bundle
+func (bundle CoreEvalBundle) ValidateBasic() error {
+	if len(bundle.CompressedBundle) == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Bundle cannot be empty")
+	}
+	if !(bundle.UncompressedSize > 0) {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Uncompressed size must be positive")
+	}
+	if bundle.UncompressedSize >= bundleUncompressedSizeLimit {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Uncompressed size out of range")
+	}
+	return nil
+}
+
+// bundleIDFields are the fields of a bundle JSON used to identify it.
+type bundleIDFields struct {
+	ModuleFormat        string `json:"moduleFormat"`
+	EndoZipBase64Sha512 string `json:"endoZipBase64Sha512"`
+}
+
+// BundleID returns the ID under which SwingSet installs the bundle carried by
+// the message, uncompressing a copy of the bundle if needed. Bundles in the
+// endoZipBase64 format are identified by their declared hash, and others by the
+// hash of their JSON text.
+func (msg MsgInstallBundle) BundleID() (string, error) {
+	if err := msg.Uncompress(); err != nil {
+		return "", err
+	}
+	var fields bundleIDFields
+	if err := json.Unmarshal([]byte(msg.Bundle), &fields); err != nil {
+		return "", sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Bundle is not valid JSON: %s", err)
+	}
+	if fields.ModuleFormat == "endoZipBase64" && fields.EndoZipBase64Sha512 != "" {
+		return "b1-" + fields.EndoZipBase64Sha512, nil
+	}
+	return "b0-" + BundleHashOf(msg.Bundle), nil
+}
+
+// validateBundleHash checks that a bundle hash is a lowercase hex-encoded
+// SHA-512 hash.
+func validateBundleHash(bundleHash string) error {
+	if len(bundleHash) != bundleHashLength || strings.ToLower(bundleHash) != bundleHash {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "Bundle hash must be a lowercase hex-encoded SHA-512 hash")
+	}
+	if _, err := hex.DecodeString(bundleHash); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Bundle hash is not valid hex: %s", err)
+	}
+	return nil
+}
+
+func NewMsgBeginBundleUpload(submitter sdk.AccAddress, bundleHash string, compressedSize, uncompressedSize int64) *MsgBeginBundleUpload {
+	return &MsgBeginBundleUpload{
+		Submitter:        submitter,
+		BundleHash:       bundleHash,
+		CompressedSize:   compressedSize,
+		UncompressedSize: uncompressedSize,
+	}
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+// The storage of the whole uncompressed bundle is charged up front.
+func (msg MsgBeginBundleUpload) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	return chargeAdmission(ctx, keeper, msg.Submitter, nil, msg.GetAdmissionStorageSize())
+}
+
+// GetAdmissionStorageSize returns the number of storage bytes charged on
+// admission, which is the uncompressed size of the whole bundle.
+func (msg MsgBeginBundleUpload) GetAdmissionStorageSize() uint64 {
+	return uint64(msg.UncompressedSize)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgBeginBundleUpload) GetInboundMsgCount() int32 {
+	return 0
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgBeginBundleUpload) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// Route should return the name of the module
+func (msg MsgBeginBundleUpload) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgBeginBundleUpload) Type() string { return "beginBundleUpload" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgBeginBundleUpload) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if err := validateBundleHash(msg.BundleHash); err != nil {
+		return err
+	}
+	if !(msg.CompressedSize > 0) || !(msg.UncompressedSize > 0) {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Bundle sizes must be positive")
+	}
+	if msg.CompressedSize >= BundleUploadSizeLimit || msg.UncompressedSize >= BundleUploadSizeLimit {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Bundle size out of range")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBeginBundleUpload) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBeginBundleUpload) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+func NewMsgUploadBundleChunk(submitter sdk.AccAddress, bundleHash string, chunkIndex uint32, chunk []byte) *MsgUploadBundleChunk {
+	return &MsgUploadBundleChunk{
+		Submitter:  submitter,
+		BundleHash: bundleHash,
+		ChunkIndex: chunkIndex,
+		Chunk:      chunk,
+	}
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgUploadBundleChunk) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	beansPerUnit := keeper.GetBeansPerUnit(ctx)
+	beans := beansPerUnit[BeansPerInboundTx]
+	if chunkBeans, ok := beansPerUnit[BeansPerBundleChunk]; ok {
+		beans = beans.Add(chunkBeans)
+	}
+	beans = beans.Add(beansPerUnit[BeansPerMessageByte].MulUint64(uint64(len(msg.Chunk))))
+	return keeper.ChargeBeans(ctx, msg.Submitter, beans)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgUploadBundleChunk) GetInboundMsgCount() int32 {
+	return 0
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgUploadBundleChunk) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// Route should return the name of the module
+func (msg MsgUploadBundleChunk) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUploadBundleChunk) Type() string { return "uploadBundleChunk" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUploadBundleChunk) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if err := validateBundleHash(msg.BundleHash); err != nil {
+		return err
+	}
+	if len(msg.Chunk) == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Chunk cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUploadBundleChunk) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUploadBundleChunk) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+func NewMsgFinishBundleUpload(submitter sdk.AccAddress, bundleHash string) *MsgFinishBundleUpload {
+	return &MsgFinishBundleUpload{
+		Submitter:  submitter,
+		BundleHash: bundleHash,
+	}
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgFinishBundleUpload) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	return chargeAdmission(ctx, keeper, msg.Submitter, nil, 0)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgFinishBundleUpload) GetInboundMsgCount() int32 {
+	return 1
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgFinishBundleUpload) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// Route should return the name of the module
+func (msg MsgFinishBundleUpload) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgFinishBundleUpload) Type() string { return "finishBundleUpload" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgFinishBundleUpload) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	return validateBundleHash(msg.BundleHash)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgFinishBundleUpload) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgFinishBundleUpload) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+// BundleHashOf returns the hex-encoded SHA-512 hash of a bundle, as declared
+// by MsgBeginBundleUpload.
+func BundleHashOf(bundle string) string {
+	sum := sha512.Sum512([]byte(bundle))
+	return hex.EncodeToString(sum[:])
+}
+
+func NewMsgSetInboundPause(authority string, pause InboundPause) *MsgSetInboundPause {
+	return &MsgSetInboundPause{
+		Authority: authority,
+		Pause:     pause,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetInboundPause) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetInboundPause) Type() string { return "setInboundPause" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetInboundPause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := msg.Pause.ValidateBasic(); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pause: %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetInboundPause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetInboundPause) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgPurgeInboundQueue(authority string, queue string, criteria InboundQueuePurgeCriteria) *MsgPurgeInboundQueue {
+	return &MsgPurgeInboundQueue{
+		Authority: authority,
+		Queue:     queue,
+		Criteria:  criteria,
+	}
+}
+
+// QueuePath returns the vstorage path of the queue to purge.
+func (msg MsgPurgeInboundQueue) QueuePath() string {
+	if msg.Queue == "" {
+		return InboundQueueAction
+	}
+	return msg.Queue
+}
+
+// Route should return the name of the module
+func (msg MsgPurgeInboundQueue) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgPurgeInboundQueue) Type() string { return "purgeInboundQueue" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgPurgeInboundQueue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	queuePath := msg.QueuePath()
+	if queuePath != InboundQueueAction && queuePath != InboundQueueHighPriority {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown inbound queue %q", msg.Queue)
+	}
+	criteria := msg.Criteria
+	if criteria.StartIndex == 0 && criteria.EndIndex == 0 &&
+		criteria.ActionType == "" && criteria.Sender == "" && criteria.TxHash == "" {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "no purge criteria provided")
+	}
+	if criteria.EndIndex != 0 && criteria.EndIndex <= criteria.StartIndex {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty purge index range")
+	}
+	if criteria.Sender != "" {
+		if queuePath != InboundQueueAction {
+			return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "senders are not recorded for %s", queuePath)
+		}
+		if _, err := sdk.AccAddressFromBech32(criteria.Sender); err != nil {
+			return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgPurgeInboundQueue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgPurgeInboundQueue) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
@@ -321,6 +321,278 @@ func (m *MsgWalletSpendActionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWalletSpendActionResponse proto.InternalMessageInfo
 
+// MsgWalletOffer defines an SDK message for the on-chain wallet to execute an
+// offer.  Unlike MsgWalletSpendAction, its parts are validated when the
+// transaction is checked, and it is delivered to the wallet as the equivalent
+// "executeOffer" spend action.  Each part is JSON-stringified CapData in the
+// "smallcaps" encoding.
+type MsgWalletOffer struct {
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner" yaml:"owner"`
+	// The id of the offer, unique among the offers of the wallet.
+	OfferId string `protobuf:"bytes,2,opt,name=offer_id,json=offerId,proto3" json:"offerId" yaml:"offerId"`
+	// The InvitationSpec of the offer, as a record with a "source".
+	InvitationSpec string `protobuf:"bytes,3,opt,name=invitation_spec,json=invitationSpec,proto3" json:"invitationSpec" yaml:"invitationSpec"`
+	// The Proposal of the offer, as a record of "give", "want" and "exit".
+	Proposal string `protobuf:"bytes,4,opt,name=proposal,proto3" json:"proposal" yaml:"proposal"`
+	// The optional offer args, as a record.
+	OfferArgs string `protobuf:"bytes,5,opt,name=offer_args,json=offerArgs,proto3" json:"offerArgs" yaml:"offerArgs"`
+}
+
+func (m *MsgWalletOffer) Reset()         { *m = MsgWalletOffer{} }
+func (m *MsgWalletOffer) String() string { return proto.CompactTextString(m) }
+func (*MsgWalletOffer) ProtoMessage()    {}
+func (*MsgWalletOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{6}
+}
+func (m *MsgWalletOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWalletOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWalletOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWalletOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWalletOffer.Merge(m, src)
+}
+func (m *MsgWalletOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWalletOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWalletOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWalletOffer proto.InternalMessageInfo
+
+func (m *MsgWalletOffer) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *MsgWalletOffer) GetOfferId() string {
+	if m != nil {
+		return m.OfferId
+	}
+	return ""
+}
+
+func (m *MsgWalletOffer) GetInvitationSpec() string {
+	if m != nil {
+		return m.InvitationSpec
+	}
+	return ""
+}
+
+func (m *MsgWalletOffer) GetProposal() string {
+	if m != nil {
+		return m.Proposal
+	}
+	return ""
+}
+
+func (m *MsgWalletOffer) GetOfferArgs() string {
+	if m != nil {
+		return m.OfferArgs
+	}
+	return ""
+}
+
+// MsgWalletOfferResponse is an empty reply.
+type MsgWalletOfferResponse struct {
+}
+
+func (m *MsgWalletOfferResponse) Reset()         { *m = MsgWalletOfferResponse{} }
+func (m *MsgWalletOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWalletOfferResponse) ProtoMessage()    {}
+func (*MsgWalletOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{7}
+}
+func (m *MsgWalletOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWalletOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWalletOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWalletOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWalletOfferResponse.Merge(m, src)
+}
+func (m *MsgWalletOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWalletOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWalletOfferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWalletOfferResponse proto.InternalMessageInfo
+
+// MsgWalletActionBatch defines an SDK message for the on-chain wallet to
+// perform an ordered list of actions.  The batch is charged as one inbound
+// transaction plus the message and byte charges of each action, and is
+// delivered to the wallet as a single action.  A batch has at most 32 actions
+// (see WalletActionBatchSizeLimit).
+type MsgWalletActionBatch struct {
+	Owner   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner" yaml:"owner"`
+	Actions []WalletBatchAction                           `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions" yaml:"actions"`
+}
+
+func (m *MsgWalletActionBatch) Reset()         { *m = MsgWalletActionBatch{} }
+func (m *MsgWalletActionBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgWalletActionBatch) ProtoMessage()    {}
+func (*MsgWalletActionBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{8}
+}
+func (m *MsgWalletActionBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWalletActionBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWalletActionBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWalletActionBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWalletActionBatch.Merge(m, src)
+}
+func (m *MsgWalletActionBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWalletActionBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWalletActionBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWalletActionBatch proto.InternalMessageInfo
+
+func (m *MsgWalletActionBatch) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *MsgWalletActionBatch) GetActions() []WalletBatchAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+// WalletBatchAction is an action of a MsgWalletActionBatch.
+type WalletBatchAction struct {
+	// The action to perform, as JSON-stringified marshalled data.
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action" yaml:"action"`
+	// Whether the action may spend the owner's assets, as with
+	// MsgWalletSpendAction.
+	Spend bool `protobuf:"varint,2,opt,name=spend,proto3" json:"spend" yaml:"spend"`
+}
+
+func (m *WalletBatchAction) Reset()         { *m = WalletBatchAction{} }
+func (m *WalletBatchAction) String() string { return proto.CompactTextString(m) }
+func (*WalletBatchAction) ProtoMessage()    {}
+func (*WalletBatchAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{9}
+}
+func (m *WalletBatchAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WalletBatchAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WalletBatchAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WalletBatchAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletBatchAction.Merge(m, src)
+}
+func (m *WalletBatchAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *WalletBatchAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletBatchAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletBatchAction proto.InternalMessageInfo
+
+func (m *WalletBatchAction) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *WalletBatchAction) GetSpend() bool {
+	if m != nil {
+		return m.Spend
+	}
+	return false
+}
+
+// MsgWalletActionBatchResponse is an empty reply.
+type MsgWalletActionBatchResponse struct {
+}
+
+func (m *MsgWalletActionBatchResponse) Reset()         { *m = MsgWalletActionBatchResponse{} }
+func (m *MsgWalletActionBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWalletActionBatchResponse) ProtoMessage()    {}
+func (*MsgWalletActionBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{10}
+}
+func (m *MsgWalletActionBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWalletActionBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWalletActionBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWalletActionBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWalletActionBatchResponse.Merge(m, src)
+}
+func (m *MsgWalletActionBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWalletActionBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWalletActionBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWalletActionBatchResponse proto.InternalMessageInfo
+
 // MsgProvision defines an SDK message for provisioning a client to the chain
 type MsgProvision struct {
 	Nickname   string                                        `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname" yaml:"nickname"`
@@ -333,7 +605,7 @@ func (m *MsgProvision) Reset()         { *m = MsgProvision{} }
 func (m *MsgProvision) String() string { return proto.CompactTextString(m) }
 func (*MsgProvision) ProtoMessage()    {}
 func (*MsgProvision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_788baa062b181a57, []int{6}
+	return fileDescriptor_788baa062b181a57, []int{11}
 }
 func (m *MsgProvision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,7 +670,7 @@ func (m *MsgProvisionResponse) Reset()         { *m = MsgProvisionResponse{} }
 func (m *MsgProvisionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProvisionResponse) ProtoMessage()    {}
 func (*MsgProvisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_788baa062b181a57, []int{7}
+	return fileDescriptor_788baa062b181a57, []int{12}
 }
 func (m *MsgProvisionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,29 +699,30 @@ func (m *MsgProvisionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgProvisionResponse proto.InternalMessageInfo
 
-// MsgInstallBundle carries a signed bundle to SwingSet.
-type MsgInstallBundle struct {
-	Bundle    string                                        `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle" yaml:"bundle"`
-	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
-	// Either bundle or compressed_bundle will be set.
-	// Default compression algorithm is gzip.
-	CompressedBundle []byte `protobuf:"bytes,3,opt,name=compressed_bundle,json=compressedBundle,proto3" json:"compressedBundle" yaml:"compressedBundle"`
-	// Size in bytes of uncompression of compressed_bundle.
-	UncompressedSize int64 `protobuf:"varint,4,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressedSize"`
+// MsgUpdateEgressPowerFlags defines an SDK message for adding or removing power
+// flags of a provisioned endpoint.  The submitter must be the endpoint itself
+// or the governance authority.  Only the power flags of the PowerFlagFees may
+// be added, and the endpoint is charged their fees unless it holds a
+// provisionpass.
+type MsgUpdateEgressPowerFlags struct {
+	Address          github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address" yaml:"address"`
+	AddPowerFlags    []string                                      `protobuf:"bytes,2,rep,name=add_power_flags,json=addPowerFlags,proto3" json:"addPowerFlags" yaml:"addPowerFlags"`
+	RemovePowerFlags []string                                      `protobuf:"bytes,3,rep,name=remove_power_flags,json=removePowerFlags,proto3" json:"removePowerFlags" yaml:"removePowerFlags"`
+	Submitter        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
 }
 
-func (m *MsgInstallBundle) Reset()         { *m = MsgInstallBundle{} }
-func (m *MsgInstallBundle) String() string { return proto.CompactTextString(m) }
-func (*MsgInstallBundle) ProtoMessage()    {}
-func (*MsgInstallBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_788baa062b181a57, []int{8}
+func (m *MsgUpdateEgressPowerFlags) Reset()         { *m = MsgUpdateEgressPowerFlags{} }
+func (m *MsgUpdateEgressPowerFlags) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEgressPowerFlags) ProtoMessage()    {}
+func (*MsgUpdateEgressPowerFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{13}
 }
-func (m *MsgInstallBundle) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateEgressPowerFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstallBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateEgressPowerFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstallBundle.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateEgressPowerFlags.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -459,63 +732,64 @@ func (m *MsgInstallBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgInstallBundle) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstallBundle.Merge(m, src)
+func (m *MsgUpdateEgressPowerFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEgressPowerFlags.Merge(m, src)
 }
-func (m *MsgInstallBundle) XXX_Size() int {
+func (m *MsgUpdateEgressPowerFlags) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstallBundle) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstallBundle.DiscardUnknown(m)
+func (m *MsgUpdateEgressPowerFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEgressPowerFlags.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstallBundle proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateEgressPowerFlags proto.InternalMessageInfo
 
-func (m *MsgInstallBundle) GetBundle() string {
+func (m *MsgUpdateEgressPowerFlags) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Bundle
+		return m.Address
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgInstallBundle) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgUpdateEgressPowerFlags) GetAddPowerFlags() []string {
 	if m != nil {
-		return m.Submitter
+		return m.AddPowerFlags
 	}
 	return nil
 }
 
-func (m *MsgInstallBundle) GetCompressedBundle() []byte {
+func (m *MsgUpdateEgressPowerFlags) GetRemovePowerFlags() []string {
 	if m != nil {
-		return m.CompressedBundle
+		return m.RemovePowerFlags
 	}
 	return nil
 }
 
-func (m *MsgInstallBundle) GetUncompressedSize() int64 {
+func (m *MsgUpdateEgressPowerFlags) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.UncompressedSize
+		return m.Submitter
 	}
-	return 0
+	return nil
 }
 
-// MsgInstallBundleResponse is an empty acknowledgement that an install bundle
-// message has been queued for the SwingSet kernel's consideration.
-type MsgInstallBundleResponse struct {
+// MsgUpdateEgressPowerFlagsResponse is the reply with the resulting power
+// flags of the endpoint.
+type MsgUpdateEgressPowerFlagsResponse struct {
+	PowerFlags []string `protobuf:"bytes,1,rep,name=power_flags,json=powerFlags,proto3" json:"powerFlags" yaml:"powerFlags"`
 }
 
-func (m *MsgInstallBundleResponse) Reset()         { *m = MsgInstallBundleResponse{} }
-func (m *MsgInstallBundleResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgInstallBundleResponse) ProtoMessage()    {}
-func (*MsgInstallBundleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_788baa062b181a57, []int{9}
+func (m *MsgUpdateEgressPowerFlagsResponse) Reset()         { *m = MsgUpdateEgressPowerFlagsResponse{} }
+func (m *MsgUpdateEgressPowerFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEgressPowerFlagsResponse) ProtoMessage()    {}
+func (*MsgUpdateEgressPowerFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{14}
 }
-func (m *MsgInstallBundleResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateEgressPowerFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstallBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateEgressPowerFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstallBundleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateEgressPowerFlagsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -525,851 +799,6084 @@ func (m *MsgInstallBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgInstallBundleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstallBundleResponse.Merge(m, src)
+func (m *MsgUpdateEgressPowerFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEgressPowerFlagsResponse.Merge(m, src)
 }
-func (m *MsgInstallBundleResponse) XXX_Size() int {
+func (m *MsgUpdateEgressPowerFlagsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstallBundleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstallBundleResponse.DiscardUnknown(m)
+func (m *MsgUpdateEgressPowerFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEgressPowerFlagsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstallBundleResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateEgressPowerFlagsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgDeliverInbound)(nil), "agoric.swingset.MsgDeliverInbound")
-	proto.RegisterType((*MsgDeliverInboundResponse)(nil), "agoric.swingset.MsgDeliverInboundResponse")
-	proto.RegisterType((*MsgWalletAction)(nil), "agoric.swingset.MsgWalletAction")
-	proto.RegisterType((*MsgWalletActionResponse)(nil), "agoric.swingset.MsgWalletActionResponse")
-	proto.RegisterType((*MsgWalletSpendAction)(nil), "agoric.swingset.MsgWalletSpendAction")
-	proto.RegisterType((*MsgWalletSpendActionResponse)(nil), "agoric.swingset.MsgWalletSpendActionResponse")
-	proto.RegisterType((*MsgProvision)(nil), "agoric.swingset.MsgProvision")
-	proto.RegisterType((*MsgProvisionResponse)(nil), "agoric.swingset.MsgProvisionResponse")
-	proto.RegisterType((*MsgInstallBundle)(nil), "agoric.swingset.MsgInstallBundle")
-	proto.RegisterType((*MsgInstallBundleResponse)(nil), "agoric.swingset.MsgInstallBundleResponse")
+func (m *MsgUpdateEgressPowerFlagsResponse) GetPowerFlags() []string {
+	if m != nil {
+		return m.PowerFlags
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("agoric/swingset/msgs.proto", fileDescriptor_788baa062b181a57) }
-
-var fileDescriptor_788baa062b181a57 = []byte{
-	// 788 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xe3, 0x50, 0x36, 0xaf, 0xd9, 0x6d, 0x63, 0x95, 0xad, 0xd7, 0x0b, 0x99, 0xac, 0xa5,
-	0x15, 0x01, 0xd4, 0x44, 0xb0, 0xb7, 0xed, 0x29, 0x16, 0x42, 0x5a, 0xa4, 0xa0, 0xc5, 0x2b, 0x84,
-	0x54, 0x81, 0x5a, 0xc7, 0x19, 0x5c, 0x2b, 0xb6, 0xc7, 0xf2, 0x38, 0x2d, 0xed, 0x8d, 0xff, 0x00,
-	0xfe, 0x01, 0x04, 0xff, 0x0d, 0xc7, 0x1e, 0x11, 0x87, 0x51, 0x95, 0x5e, 0x90, 0x8f, 0x3e, 0x72,
-	0x42, 0x9e, 0xf1, 0x8f, 0xfc, 0x82, 0xa2, 0x1e, 0xca, 0x29, 0x79, 0xdf, 0xf7, 0xbd, 0xf7, 0xbe,
-	0x99, 0x79, 0x63, 0x1b, 0x34, 0xcb, 0x21, 0x91, 0x6b, 0x0f, 0xe8, 0xb9, 0x1b, 0x38, 0x14, 0xc7,
-	0x03, 0x9f, 0x3a, 0xb4, 0x1f, 0x46, 0x24, 0x26, 0xca, 0x8e, 0xe0, 0xfa, 0x05, 0xa7, 0xed, 0x39,
-	0xc4, 0x21, 0x9c, 0x1b, 0x64, 0xff, 0x84, 0x4c, 0xff, 0xb9, 0x0e, 0xed, 0x11, 0x75, 0x3e, 0xc5,
-	0x9e, 0x7b, 0x86, 0xa3, 0x57, 0xc1, 0x98, 0xcc, 0x82, 0x89, 0x72, 0x08, 0x0f, 0x7c, 0x4c, 0xa9,
-	0xe5, 0x60, 0xaa, 0x4a, 0x5d, 0xb9, 0xd7, 0x34, 0x50, 0xc2, 0x50, 0x89, 0xa5, 0x0c, 0xed, 0x5c,
-	0x58, 0xbe, 0xf7, 0x52, 0x2f, 0x10, 0xdd, 0x2c, 0x49, 0xe5, 0x23, 0x68, 0x04, 0x33, 0x9f, 0xaa,
-	0xf5, 0xae, 0xdc, 0x6b, 0x18, 0xfb, 0x09, 0x43, 0x3c, 0x4e, 0x19, 0xda, 0x16, 0x49, 0x59, 0xa4,
-	0x9b, 0x1c, 0x54, 0xde, 0x07, 0xd9, 0xb2, 0xa7, 0xaa, 0xdc, 0x95, 0x7a, 0x0d, 0xe3, 0x9d, 0x84,
-	0xa1, 0x2c, 0x4c, 0x19, 0x02, 0x21, 0xb5, 0xec, 0xa9, 0x6e, 0x66, 0x90, 0x12, 0x42, 0x93, 0xce,
-	0xc6, 0xbe, 0x1b, 0xc7, 0x38, 0x52, 0x1b, 0x5d, 0xa9, 0xd7, 0x32, 0xcc, 0x84, 0xa1, 0x0a, 0x4c,
-	0x19, 0xda, 0x15, 0x49, 0x25, 0xa4, 0xff, 0xc5, 0xd0, 0x81, 0xe3, 0xc6, 0xa7, 0xb3, 0x71, 0xdf,
-	0x26, 0xfe, 0xc0, 0x26, 0xd4, 0x27, 0x34, 0xff, 0x39, 0xa0, 0x93, 0xe9, 0x20, 0xbe, 0x08, 0x31,
-	0xed, 0x0f, 0x6d, 0x7b, 0x38, 0x99, 0x44, 0x98, 0x52, 0xb3, 0xaa, 0xf7, 0xb2, 0xf1, 0xe7, 0x2f,
-	0xa8, 0xa6, 0x3f, 0x85, 0x27, 0x6b, 0xfb, 0x63, 0x62, 0x1a, 0x92, 0x80, 0x62, 0xfd, 0x27, 0x09,
-	0x76, 0x46, 0xd4, 0xf9, 0xda, 0xf2, 0x3c, 0x1c, 0x0f, 0xed, 0xd8, 0x25, 0x81, 0x72, 0x02, 0x6f,
-	0x91, 0xf3, 0x00, 0x47, 0xaa, 0xc4, 0x4d, 0x7e, 0x9e, 0x30, 0x24, 0x80, 0x94, 0xa1, 0x96, 0x30,
-	0xc8, 0xc3, 0x3b, 0x98, 0x13, 0x75, 0x94, 0xc7, 0xb0, 0x65, 0xf1, 0x5e, 0x6a, 0xbd, 0x2b, 0xf5,
-	0x9a, 0x66, 0x1e, 0xe5, 0x86, 0x9f, 0xc0, 0xfe, 0x8a, 0xa5, 0xd2, 0xee, 0xaf, 0x12, 0xec, 0x95,
-	0xdc, 0x9b, 0x10, 0x07, 0x93, 0x7b, 0xf3, 0xfc, 0x0c, 0x5a, 0x34, 0x6b, 0x78, 0xbc, 0xe4, 0x7c,
-	0x9b, 0x56, 0x26, 0x72, 0xfb, 0x1d, 0x78, 0x77, 0x93, 0xc5, 0x72, 0x0d, 0x3f, 0xc8, 0xd0, 0x1a,
-	0x51, 0xe7, 0x75, 0x44, 0xce, 0x5c, 0x9a, 0x79, 0x3f, 0x84, 0x07, 0x81, 0x6b, 0x4f, 0x03, 0xcb,
-	0xc7, 0xdc, 0x7e, 0x3e, 0xab, 0x05, 0x56, 0xcd, 0x6a, 0x81, 0xe8, 0x66, 0x49, 0x2a, 0xa7, 0xf0,
-	0xb6, 0x25, 0x8c, 0x72, 0x47, 0x2d, 0xe3, 0x8b, 0x84, 0xa1, 0x02, 0x4a, 0x19, 0x7a, 0x94, 0x8f,
-	0xa1, 0x00, 0xee, 0xb0, 0xfc, 0xa2, 0x96, 0x62, 0xc2, 0x76, 0x48, 0xce, 0x71, 0x74, 0xfc, 0x9d,
-	0x67, 0x39, 0x54, 0x95, 0xf9, 0xad, 0xfa, 0x78, 0xce, 0x10, 0xbc, 0xce, 0xe0, 0xcf, 0x32, 0x34,
-	0x61, 0x08, 0xc2, 0x32, 0x4a, 0x19, 0x6a, 0x8b, 0xf6, 0x15, 0xa6, 0x9b, 0x0b, 0x82, 0xff, 0xed,
-	0x4e, 0x3c, 0xe6, 0x63, 0x54, 0x1e, 0x41, 0x79, 0x36, 0x7f, 0xd4, 0x61, 0x77, 0x44, 0x9d, 0x57,
-	0x01, 0x8d, 0x2d, 0xcf, 0x33, 0x66, 0xc1, 0xc4, 0xc3, 0xca, 0x0b, 0xd8, 0x1a, 0xf3, 0x7f, 0xf9,
-	0xe9, 0x3c, 0x4d, 0x18, 0xca, 0x91, 0x94, 0xa1, 0x87, 0xc2, 0x9e, 0x88, 0x75, 0x33, 0x27, 0x96,
-	0x57, 0x56, 0xbf, 0x87, 0x95, 0x29, 0xdf, 0x40, 0xdb, 0x26, 0x7e, 0x98, 0xc1, 0x78, 0x72, 0x9c,
-	0x3b, 0x96, 0x79, 0xe7, 0x41, 0xc2, 0xd0, 0x6e, 0x45, 0x1a, 0x85, 0xf7, 0x7d, 0x61, 0x60, 0x95,
-	0xd1, 0xcd, 0x35, 0xb1, 0x32, 0x84, 0xf6, 0x2c, 0x58, 0xa8, 0x4f, 0xdd, 0x4b, 0xcc, 0x4f, 0x4c,
-	0x36, 0xf6, 0xb2, 0xea, 0x8b, 0xe4, 0x1b, 0xf7, 0x12, 0x9b, 0x6b, 0x88, 0xae, 0x81, 0xba, 0xba,
-	0xb7, 0xc5, 0xc6, 0x7f, 0x72, 0x2d, 0x83, 0x3c, 0xa2, 0x8e, 0xf2, 0x2d, 0x3c, 0x5c, 0xde, 0xfc,
-	0x67, 0xfd, 0x95, 0xd7, 0x40, 0x7f, 0xb5, 0x86, 0xf6, 0xc1, 0xad, 0x92, 0xa2, 0x8d, 0x72, 0x02,
-	0x8f, 0x56, 0x5e, 0x14, 0xfa, 0xa6, 0xe4, 0x65, 0x8d, 0xf6, 0xe1, 0xed, 0x9a, 0xb2, 0xc3, 0x11,
-	0xb4, 0x96, 0x1e, 0xa6, 0xdd, 0x4d, 0xb9, 0x8b, 0x0a, 0xad, 0x77, 0x9b, 0xa2, 0xac, 0xed, 0x42,
-	0x7b, 0xfd, 0xc9, 0xf7, 0xfc, 0x9f, 0xd3, 0x17, 0x64, 0xda, 0xc1, 0x7f, 0x92, 0x95, 0xad, 0xbe,
-	0x84, 0x66, 0xf5, 0x80, 0x7a, 0x6f, 0x53, 0x6e, 0x49, 0x6b, 0xcf, 0xff, 0x95, 0x2e, 0x4a, 0x1a,
-	0x5f, 0xfd, 0x36, 0xef, 0x48, 0x57, 0xf3, 0x8e, 0x74, 0x3d, 0xef, 0x48, 0x3f, 0xde, 0x74, 0x6a,
-	0x57, 0x37, 0x9d, 0xda, 0xef, 0x37, 0x9d, 0xda, 0xd1, 0xe1, 0xc2, 0xcc, 0x0f, 0xc5, 0x07, 0x81,
-	0xa8, 0xc8, 0x67, 0xde, 0x21, 0x9e, 0x15, 0x38, 0xc5, 0x65, 0xf8, 0xbe, 0xfa, 0x56, 0xe0, 0x97,
-	0x61, 0xbc, 0xc5, 0x3f, 0x03, 0x5e, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x51, 0x66, 0x1b, 0xd5,
-	0x4b, 0x08, 0x00, 0x00,
+// MsgDeprovision defines an SDK message for removing the egress of a
+// provisioned endpoint, e.g. when its account is compromised.  The submitter
+// must be the endpoint itself or the governance authority.
+type MsgDeprovision struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address" yaml:"address"`
+	// Why the endpoint is deprovisioned, for the record.
+	Reason    string                                        `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason" yaml:"reason"`
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgDeprovision) Reset()         { *m = MsgDeprovision{} }
+func (m *MsgDeprovision) String() string { return proto.CompactTextString(m) }
+func (*MsgDeprovision) ProtoMessage()    {}
+func (*MsgDeprovision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{15}
+}
+func (m *MsgDeprovision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeprovision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeprovision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeprovision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeprovision.Merge(m, src)
+}
+func (m *MsgDeprovision) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeprovision) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeprovision.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgDeprovision proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Install a JavaScript sources bundle on the chain's SwingSet controller.
-	InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error)
-	// Send inbound messages.
-	DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error)
-	// Perform a low-privilege wallet action.
-	WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error)
-	// Perform a wallet action that spends assets.
-	WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error)
-	// Provision a new endpoint.
-	Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error)
+func (m *MsgDeprovision) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgDeprovision) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgDeprovision) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
+	}
+	return nil
 }
 
-func (c *msgClient) InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error) {
-	out := new(MsgInstallBundleResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/InstallBundle", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgDeprovisionResponse is an empty reply.
+type MsgDeprovisionResponse struct {
+}
+
+func (m *MsgDeprovisionResponse) Reset()         { *m = MsgDeprovisionResponse{} }
+func (m *MsgDeprovisionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeprovisionResponse) ProtoMessage()    {}
+func (*MsgDeprovisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{16}
+}
+func (m *MsgDeprovisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeprovisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeprovisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeprovisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeprovisionResponse.Merge(m, src)
+}
+func (m *MsgDeprovisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeprovisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeprovisionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error) {
-	out := new(MsgDeliverInboundResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/DeliverInbound", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeprovisionResponse proto.InternalMessageInfo
+
+// MsgInstallBundle carries a signed bundle to SwingSet.
+type MsgInstallBundle struct {
+	Bundle    string                                        `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle" yaml:"bundle"`
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	// Either bundle or compressed_bundle will be set.
+	// Default compression algorithm is gzip.
+	CompressedBundle []byte `protobuf:"bytes,3,opt,name=compressed_bundle,json=compressedBundle,proto3" json:"compressedBundle" yaml:"compressedBundle"`
+	// Size in bytes of uncompression of compressed_bundle.
+	UncompressedSize int64 `protobuf:"varint,4,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressedSize"`
+}
+
+func (m *MsgInstallBundle) Reset()         { *m = MsgInstallBundle{} }
+func (m *MsgInstallBundle) String() string { return proto.CompactTextString(m) }
+func (*MsgInstallBundle) ProtoMessage()    {}
+func (*MsgInstallBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{17}
+}
+func (m *MsgInstallBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstallBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstallBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgInstallBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstallBundle.Merge(m, src)
+}
+func (m *MsgInstallBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstallBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstallBundle.DiscardUnknown(m)
 }
 
-func (c *msgClient) WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error) {
-	out := new(MsgWalletActionResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgInstallBundle proto.InternalMessageInfo
+
+func (m *MsgInstallBundle) GetBundle() string {
+	if m != nil {
+		return m.Bundle
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error) {
-	out := new(MsgWalletSpendActionResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletSpendAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstallBundle) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error) {
-	out := new(MsgProvisionResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/Provision", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstallBundle) GetCompressedBundle() []byte {
+	if m != nil {
+		return m.CompressedBundle
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Install a JavaScript sources bundle on the chain's SwingSet controller.
-	InstallBundle(context.Context, *MsgInstallBundle) (*MsgInstallBundleResponse, error)
-	// Send inbound messages.
-	DeliverInbound(context.Context, *MsgDeliverInbound) (*MsgDeliverInboundResponse, error)
-	// Perform a low-privilege wallet action.
-	WalletAction(context.Context, *MsgWalletAction) (*MsgWalletActionResponse, error)
-	// Perform a wallet action that spends assets.
-	WalletSpendAction(context.Context, *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error)
-	// Provision a new endpoint.
-	Provision(context.Context, *MsgProvision) (*MsgProvisionResponse, error)
+func (m *MsgInstallBundle) GetUncompressedSize() int64 {
+	if m != nil {
+		return m.UncompressedSize
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgInstallBundleResponse is an empty acknowledgement that an install bundle
+// message has been queued for the SwingSet kernel's consideration.
+type MsgInstallBundleResponse struct {
 }
 
-func (*UnimplementedMsgServer) InstallBundle(ctx context.Context, req *MsgInstallBundle) (*MsgInstallBundleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstallBundle not implemented")
+func (m *MsgInstallBundleResponse) Reset()         { *m = MsgInstallBundleResponse{} }
+func (m *MsgInstallBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstallBundleResponse) ProtoMessage()    {}
+func (*MsgInstallBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{18}
 }
-func (*UnimplementedMsgServer) DeliverInbound(ctx context.Context, req *MsgDeliverInbound) (*MsgDeliverInboundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeliverInbound not implemented")
+func (m *MsgInstallBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WalletAction(ctx context.Context, req *MsgWalletAction) (*MsgWalletActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WalletAction not implemented")
+func (m *MsgInstallBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstallBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WalletSpendAction(ctx context.Context, req *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WalletSpendAction not implemented")
+func (m *MsgInstallBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstallBundleResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Provision(ctx context.Context, req *MsgProvision) (*MsgProvisionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Provision not implemented")
+func (m *MsgInstallBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstallBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstallBundleResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgInstallBundleResponse proto.InternalMessageInfo
+
+// MsgCoreEval is the gov v1 message for evaluating code in the SwingSet core.
+// Unlike the legacy CoreEvalProposal content, it can carry the bundles needed
+// by the evals, which are installed when the proposal executes.
+type MsgCoreEval struct {
+	// The address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority" yaml:"authority"`
+	// Although evals are sequential, they may run concurrently, since they each
+	// can return a Promise.
+	Evals []CoreEval `protobuf:"bytes,2,rep,name=evals,proto3" json:"evals" yaml:"evals"`
+	// Bundles to install before the evals are run.
+	Bundles []CoreEvalBundle `protobuf:"bytes,3,rep,name=bundles,proto3" json:"bundles" yaml:"bundles"`
 }
 
-func _Msg_InstallBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstallBundle)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstallBundle(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/InstallBundle",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstallBundle(ctx, req.(*MsgInstallBundle))
+func (m *MsgCoreEval) Reset()         { *m = MsgCoreEval{} }
+func (m *MsgCoreEval) String() string { return proto.CompactTextString(m) }
+func (*MsgCoreEval) ProtoMessage()    {}
+func (*MsgCoreEval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{19}
+}
+func (m *MsgCoreEval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCoreEval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCoreEval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCoreEval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCoreEval.Merge(m, src)
+}
+func (m *MsgCoreEval) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCoreEval) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCoreEval.DiscardUnknown(m)
 }
 
-func _Msg_DeliverInbound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeliverInbound)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeliverInbound(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/DeliverInbound",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeliverInbound(ctx, req.(*MsgDeliverInbound))
+var xxx_messageInfo_MsgCoreEval proto.InternalMessageInfo
+
+func (m *MsgCoreEval) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_WalletAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWalletAction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WalletAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/WalletAction",
+func (m *MsgCoreEval) GetEvals() []CoreEval {
+	if m != nil {
+		return m.Evals
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WalletAction(ctx, req.(*MsgWalletAction))
+	return nil
+}
+
+func (m *MsgCoreEval) GetBundles() []CoreEvalBundle {
+	if m != nil {
+		return m.Bundles
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_WalletSpendAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWalletSpendAction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WalletSpendAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/WalletSpendAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WalletSpendAction(ctx, req.(*MsgWalletSpendAction))
-	}
-	return interceptor(ctx, in, info, handler)
+// CoreEvalBundle is a gzip-compressed bundle attached to a MsgCoreEval.
+type CoreEvalBundle struct {
+	CompressedBundle []byte `protobuf:"bytes,1,opt,name=compressed_bundle,json=compressedBundle,proto3" json:"compressedBundle" yaml:"compressedBundle"`
+	// Size in bytes of uncompression of compressed_bundle.
+	UncompressedSize int64 `protobuf:"varint,2,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressedSize"`
 }
 
-func _Msg_Provision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProvision)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Provision(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/Provision",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Provision(ctx, req.(*MsgProvision))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *CoreEvalBundle) Reset()         { *m = CoreEvalBundle{} }
+func (m *CoreEvalBundle) String() string { return proto.CompactTextString(m) }
+func (*CoreEvalBundle) ProtoMessage()    {}
+func (*CoreEvalBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{20}
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "agoric.swingset.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "InstallBundle",
-			Handler:    _Msg_InstallBundle_Handler,
-		},
-		{
-			MethodName: "DeliverInbound",
-			Handler:    _Msg_DeliverInbound_Handler,
-		},
-		{
-			MethodName: "WalletAction",
-			Handler:    _Msg_WalletAction_Handler,
-		},
-		{
-			MethodName: "WalletSpendAction",
-			Handler:    _Msg_WalletSpendAction_Handler,
-		},
-		{
-			MethodName: "Provision",
-			Handler:    _Msg_Provision_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "agoric/swingset/msgs.proto",
+func (m *CoreEvalBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgDeliverInbound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CoreEvalBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoreEvalBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeliverInbound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CoreEvalBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoreEvalBundle.Merge(m, src)
+}
+func (m *CoreEvalBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoreEvalBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoreEvalBundle.DiscardUnknown(m)
 }
 
-func (m *MsgDeliverInbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Ack != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Ack))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Nums) > 0 {
-		dAtA2 := make([]byte, len(m.Nums)*10)
-		var j1 int
-		for _, num := range m.Nums {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintMsgs(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Messages[iNdEx])
-			copy(dAtA[i:], m.Messages[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Messages[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_CoreEvalBundle proto.InternalMessageInfo
+
+func (m *CoreEvalBundle) GetCompressedBundle() []byte {
+	if m != nil {
+		return m.CompressedBundle
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgDeliverInboundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CoreEvalBundle) GetUncompressedSize() int64 {
+	if m != nil {
+		return m.UncompressedSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeliverInboundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCoreEvalResponse is an empty acknowledgement that the core eval and its
+// bundles have been queued for the SwingSet kernel's consideration.
+type MsgCoreEvalResponse struct {
 }
 
-func (m *MsgDeliverInboundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCoreEvalResponse) Reset()         { *m = MsgCoreEvalResponse{} }
+func (m *MsgCoreEvalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCoreEvalResponse) ProtoMessage()    {}
+func (*MsgCoreEvalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{21}
 }
-
-func (m *MsgWalletAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCoreEvalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCoreEvalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCoreEvalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCoreEvalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCoreEvalResponse.Merge(m, src)
+}
+func (m *MsgCoreEvalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCoreEvalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCoreEvalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWalletAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCoreEvalResponse proto.InternalMessageInfo
+
+// MsgBeginBundleUpload declares a bundle which will be uploaded in chunks.
+// The upload is identified by the submitter and the bundle hash.
+type MsgBeginBundleUpload struct {
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	// Hex-encoded SHA-512 hash of the uncompressed bundle.
+	BundleHash string `protobuf:"bytes,2,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundleHash" yaml:"bundleHash"`
+	// Size in bytes of the gzip-compressed bundle, i.e. the sum of the chunk
+	// sizes.
+	CompressedSize int64 `protobuf:"varint,3,opt,name=compressed_size,json=compressedSize,proto3" json:"compressedSize"`
+	// Size in bytes of uncompression of the compressed bundle.
+	UncompressedSize int64 `protobuf:"varint,4,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressedSize"`
 }
 
-func (m *MsgWalletAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Action) > 0 {
-		i -= len(m.Action)
-		copy(dAtA[i:], m.Action)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Action)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgBeginBundleUpload) Reset()         { *m = MsgBeginBundleUpload{} }
+func (m *MsgBeginBundleUpload) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginBundleUpload) ProtoMessage()    {}
+func (*MsgBeginBundleUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{22}
+}
+func (m *MsgBeginBundleUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginBundleUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginBundleUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBeginBundleUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginBundleUpload.Merge(m, src)
+}
+func (m *MsgBeginBundleUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginBundleUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginBundleUpload.DiscardUnknown(m)
 }
 
-func (m *MsgWalletActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBeginBundleUpload proto.InternalMessageInfo
+
+func (m *MsgBeginBundleUpload) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgWalletActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBeginBundleUpload) GetBundleHash() string {
+	if m != nil {
+		return m.BundleHash
+	}
+	return ""
 }
 
-func (m *MsgWalletActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBeginBundleUpload) GetCompressedSize() int64 {
+	if m != nil {
+		return m.CompressedSize
+	}
+	return 0
 }
 
-func (m *MsgWalletSpendAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBeginBundleUpload) GetUncompressedSize() int64 {
+	if m != nil {
+		return m.UncompressedSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgWalletSpendAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgBeginBundleUploadResponse is an empty acknowledgement that a bundle upload
+// is pending.
+type MsgBeginBundleUploadResponse struct {
 }
 
-func (m *MsgWalletSpendAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpendAction) > 0 {
-		i -= len(m.SpendAction)
-		copy(dAtA[i:], m.SpendAction)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SpendAction)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgBeginBundleUploadResponse) Reset()         { *m = MsgBeginBundleUploadResponse{} }
+func (m *MsgBeginBundleUploadResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginBundleUploadResponse) ProtoMessage()    {}
+func (*MsgBeginBundleUploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{23}
 }
-
-func (m *MsgWalletSpendActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBeginBundleUploadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginBundleUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginBundleUploadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgWalletSpendActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBeginBundleUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginBundleUploadResponse.Merge(m, src)
 }
-
-func (m *MsgWalletSpendActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBeginBundleUploadResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgProvision) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgBeginBundleUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginBundleUploadResponse.DiscardUnknown(m)
 }
 
-func (m *MsgProvision) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgBeginBundleUploadResponse proto.InternalMessageInfo
+
+// MsgUploadBundleChunk carries the next chunk of a pending bundle upload.
+type MsgUploadBundleChunk struct {
+	Submitter  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	BundleHash string                                        `protobuf:"bytes,2,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundleHash" yaml:"bundleHash"`
+	// The index of the chunk, which must be the number of chunks already
+	// uploaded.
+	ChunkIndex uint32 `protobuf:"varint,3,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunkIndex" yaml:"chunkIndex"`
+	Chunk      []byte `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk" yaml:"chunk"`
 }
 
-func (m *MsgProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PowerFlags) > 0 {
-		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PowerFlags[iNdEx])
-			copy(dAtA[i:], m.PowerFlags[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgUploadBundleChunk) Reset()         { *m = MsgUploadBundleChunk{} }
+func (m *MsgUploadBundleChunk) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadBundleChunk) ProtoMessage()    {}
+func (*MsgUploadBundleChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{24}
+}
+func (m *MsgUploadBundleChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUploadBundleChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadBundleChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgUploadBundleChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadBundleChunk.Merge(m, src)
+}
+func (m *MsgUploadBundleChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUploadBundleChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadBundleChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUploadBundleChunk proto.InternalMessageInfo
+
+func (m *MsgUploadBundleChunk) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
 	}
-	if len(m.Nickname) > 0 {
-		i -= len(m.Nickname)
-		copy(dAtA[i:], m.Nickname)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Nickname)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgUploadBundleChunk) GetBundleHash() string {
+	if m != nil {
+		return m.BundleHash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgProvisionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUploadBundleChunk) GetChunkIndex() uint32 {
+	if m != nil {
+		return m.ChunkIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUploadBundleChunk) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
 }
 
-func (m *MsgProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUploadBundleChunkResponse is an empty acknowledgement that a chunk has
+// been stored.
+type MsgUploadBundleChunkResponse struct {
 }
 
-func (m *MsgInstallBundle) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUploadBundleChunkResponse) Reset()         { *m = MsgUploadBundleChunkResponse{} }
+func (m *MsgUploadBundleChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadBundleChunkResponse) ProtoMessage()    {}
+func (*MsgUploadBundleChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{25}
+}
+func (m *MsgUploadBundleChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUploadBundleChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadBundleChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUploadBundleChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadBundleChunkResponse.Merge(m, src)
+}
+func (m *MsgUploadBundleChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUploadBundleChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadBundleChunkResponse.DiscardUnknown(m)
 }
 
-func (m *MsgInstallBundle) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUploadBundleChunkResponse proto.InternalMessageInfo
+
+// MsgFinishBundleUpload assembles the chunks of a pending bundle upload,
+// verifies the bundle hash and installs the bundle.
+type MsgFinishBundleUpload struct {
+	Submitter  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	BundleHash string                                        `protobuf:"bytes,2,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundleHash" yaml:"bundleHash"`
 }
 
-func (m *MsgInstallBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UncompressedSize != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.CompressedBundle) > 0 {
-		i -= len(m.CompressedBundle)
-		copy(dAtA[i:], m.CompressedBundle)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CompressedBundle)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Bundle) > 0 {
-		i -= len(m.Bundle)
-		copy(dAtA[i:], m.Bundle)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Bundle)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgFinishBundleUpload) Reset()         { *m = MsgFinishBundleUpload{} }
+func (m *MsgFinishBundleUpload) String() string { return proto.CompactTextString(m) }
+func (*MsgFinishBundleUpload) ProtoMessage()    {}
+func (*MsgFinishBundleUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{26}
+}
+func (m *MsgFinishBundleUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinishBundleUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinishBundleUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgFinishBundleUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinishBundleUpload.Merge(m, src)
+}
+func (m *MsgFinishBundleUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinishBundleUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinishBundleUpload.DiscardUnknown(m)
 }
 
-func (m *MsgInstallBundleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFinishBundleUpload proto.InternalMessageInfo
+
+func (m *MsgFinishBundleUpload) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgInstallBundleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFinishBundleUpload) GetBundleHash() string {
+	if m != nil {
+		return m.BundleHash
+	}
+	return ""
 }
 
-func (m *MsgInstallBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgFinishBundleUploadResponse is an empty acknowledgement that the assembled
+// bundle has been queued for the SwingSet kernel's consideration.
+type MsgFinishBundleUploadResponse struct {
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgFinishBundleUploadResponse) Reset()         { *m = MsgFinishBundleUploadResponse{} }
+func (m *MsgFinishBundleUploadResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinishBundleUploadResponse) ProtoMessage()    {}
+func (*MsgFinishBundleUploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{27}
 }
-func (m *MsgDeliverInbound) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFinishBundleUploadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinishBundleUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinishBundleUploadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, s := range m.Messages {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
+}
+func (m *MsgFinishBundleUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinishBundleUploadResponse.Merge(m, src)
+}
+func (m *MsgFinishBundleUploadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinishBundleUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinishBundleUploadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinishBundleUploadResponse proto.InternalMessageInfo
+
+// MsgSetInboundPause is the gov v1 message for pausing inbound messages,
+// replacing any previous pause. An empty pause resumes all inbound messages.
+type MsgSetInboundPause struct {
+	// The address of the governance module account.
+	Authority string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority" yaml:"authority"`
+	Pause     InboundPause `protobuf:"bytes,2,opt,name=pause,proto3" json:"pause" yaml:"pause"`
+}
+
+func (m *MsgSetInboundPause) Reset()         { *m = MsgSetInboundPause{} }
+func (m *MsgSetInboundPause) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInboundPause) ProtoMessage()    {}
+func (*MsgSetInboundPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{28}
+}
+func (m *MsgSetInboundPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInboundPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInboundPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Nums) > 0 {
-		l = 0
-		for _, e := range m.Nums {
-			l += sovMsgs(uint64(e))
-		}
-		n += 1 + sovMsgs(uint64(l)) + l
+}
+func (m *MsgSetInboundPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInboundPause.Merge(m, src)
+}
+func (m *MsgSetInboundPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInboundPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInboundPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetInboundPause proto.InternalMessageInfo
+
+func (m *MsgSetInboundPause) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if m.Ack != 0 {
-		n += 1 + sovMsgs(uint64(m.Ack))
+	return ""
+}
+
+func (m *MsgSetInboundPause) GetPause() InboundPause {
+	if m != nil {
+		return m.Pause
 	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return InboundPause{}
+}
+
+// MsgSetInboundPauseResponse is an empty acknowledgement that the pause has
+// been set.
+type MsgSetInboundPauseResponse struct {
+}
+
+func (m *MsgSetInboundPauseResponse) Reset()         { *m = MsgSetInboundPauseResponse{} }
+func (m *MsgSetInboundPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInboundPauseResponse) ProtoMessage()    {}
+func (*MsgSetInboundPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{29}
+}
+func (m *MsgSetInboundPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInboundPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInboundPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetInboundPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInboundPauseResponse.Merge(m, src)
+}
+func (m *MsgSetInboundPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInboundPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInboundPauseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeliverInboundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetInboundPauseResponse proto.InternalMessageInfo
+
+// MsgPurgeInboundQueue is the gov v1 message for removing the records matching
+// all the given criteria from an inbound queue. The removed records are
+// archived in vstorage and reported to the VM.
+type MsgPurgeInboundQueue struct {
+	// The address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority" yaml:"authority"`
+	// The vstorage path of the queue, either "actionQueue" (the default) or
+	// "highPriorityQueue".
+	Queue    string                    `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue" yaml:"queue"`
+	Criteria InboundQueuePurgeCriteria `protobuf:"bytes,3,opt,name=criteria,proto3" json:"criteria" yaml:"criteria"`
+}
+
+func (m *MsgPurgeInboundQueue) Reset()         { *m = MsgPurgeInboundQueue{} }
+func (m *MsgPurgeInboundQueue) String() string { return proto.CompactTextString(m) }
+func (*MsgPurgeInboundQueue) ProtoMessage()    {}
+func (*MsgPurgeInboundQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{30}
+}
+func (m *MsgPurgeInboundQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurgeInboundQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurgeInboundQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgPurgeInboundQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurgeInboundQueue.Merge(m, src)
+}
+func (m *MsgPurgeInboundQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurgeInboundQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurgeInboundQueue.DiscardUnknown(m)
 }
 
-func (m *MsgWalletAction) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgPurgeInboundQueue proto.InternalMessageInfo
+
+func (m *MsgPurgeInboundQueue) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return ""
+}
+
+func (m *MsgPurgeInboundQueue) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return ""
+}
+
+func (m *MsgPurgeInboundQueue) GetCriteria() InboundQueuePurgeCriteria {
+	if m != nil {
+		return m.Criteria
 	}
-	return n
+	return InboundQueuePurgeCriteria{}
 }
 
-func (m *MsgWalletActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// InboundQueuePurgeCriteria selects inbound queue records. Unset criteria
+// match every record, but at least one must be set.
+type InboundQueuePurgeCriteria struct {
+	// The first queue index to match.
+	StartIndex uint64 `protobuf:"varint,1,opt,name=start_index,json=startIndex,proto3" json:"startIndex" yaml:"startIndex"`
+	// The queue index after the last one to match, or 0 for no limit.
+	EndIndex uint64 `protobuf:"varint,2,opt,name=end_index,json=endIndex,proto3" json:"endIndex" yaml:"endIndex"`
+	// The type of the action, e.g. "WALLET_ACTION".
+	ActionType string `protobuf:"bytes,3,opt,name=action_type,json=actionType,proto3" json:"actionType" yaml:"actionType"`
+	// The bech32 address of the sender. Only the actionQueue records their
+	// senders.
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	// The hash of the transaction which enqueued the action.
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"txHash" yaml:"txHash"`
+}
+
+func (m *InboundQueuePurgeCriteria) Reset()         { *m = InboundQueuePurgeCriteria{} }
+func (m *InboundQueuePurgeCriteria) String() string { return proto.CompactTextString(m) }
+func (*InboundQueuePurgeCriteria) ProtoMessage()    {}
+func (*InboundQueuePurgeCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{31}
+}
+func (m *InboundQueuePurgeCriteria) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundQueuePurgeCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundQueuePurgeCriteria.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *InboundQueuePurgeCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundQueuePurgeCriteria.Merge(m, src)
+}
+func (m *InboundQueuePurgeCriteria) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundQueuePurgeCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundQueuePurgeCriteria.DiscardUnknown(m)
 }
 
-func (m *MsgWalletSpendAction) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_InboundQueuePurgeCriteria proto.InternalMessageInfo
+
+func (m *InboundQueuePurgeCriteria) GetStartIndex() uint64 {
+	if m != nil {
+		return m.StartIndex
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return 0
+}
+
+func (m *InboundQueuePurgeCriteria) GetEndIndex() uint64 {
+	if m != nil {
+		return m.EndIndex
 	}
-	l = len(m.SpendAction)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return 0
+}
+
+func (m *InboundQueuePurgeCriteria) GetActionType() string {
+	if m != nil {
+		return m.ActionType
 	}
-	return n
+	return ""
 }
 
-func (m *MsgWalletSpendActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InboundQueuePurgeCriteria) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgProvision) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InboundQueuePurgeCriteria) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	var l int
-	_ = l
-	l = len(m.Nickname)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return ""
+}
+
+// MsgPurgeInboundQueueResponse reports the number of purged records.
+type MsgPurgeInboundQueueResponse struct {
+	Purged uint64 `protobuf:"varint,1,opt,name=purged,proto3" json:"purged" yaml:"purged"`
+}
+
+func (m *MsgPurgeInboundQueueResponse) Reset()         { *m = MsgPurgeInboundQueueResponse{} }
+func (m *MsgPurgeInboundQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPurgeInboundQueueResponse) ProtoMessage()    {}
+func (*MsgPurgeInboundQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{32}
+}
+func (m *MsgPurgeInboundQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurgeInboundQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurgeInboundQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+}
+func (m *MsgPurgeInboundQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurgeInboundQueueResponse.Merge(m, src)
+}
+func (m *MsgPurgeInboundQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurgeInboundQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurgeInboundQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPurgeInboundQueueResponse proto.InternalMessageInfo
+
+func (m *MsgPurgeInboundQueueResponse) GetPurged() uint64 {
+	if m != nil {
+		return m.Purged
 	}
-	if len(m.PowerFlags) > 0 {
-		for _, s := range m.PowerFlags {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgDeliverInbound)(nil), "agoric.swingset.MsgDeliverInbound")
+	proto.RegisterType((*MsgDeliverInboundResponse)(nil), "agoric.swingset.MsgDeliverInboundResponse")
+	proto.RegisterType((*MsgWalletAction)(nil), "agoric.swingset.MsgWalletAction")
+	proto.RegisterType((*MsgWalletActionResponse)(nil), "agoric.swingset.MsgWalletActionResponse")
+	proto.RegisterType((*MsgWalletSpendAction)(nil), "agoric.swingset.MsgWalletSpendAction")
+	proto.RegisterType((*MsgWalletSpendActionResponse)(nil), "agoric.swingset.MsgWalletSpendActionResponse")
+	proto.RegisterType((*MsgWalletOffer)(nil), "agoric.swingset.MsgWalletOffer")
+	proto.RegisterType((*MsgWalletOfferResponse)(nil), "agoric.swingset.MsgWalletOfferResponse")
+	proto.RegisterType((*MsgWalletActionBatch)(nil), "agoric.swingset.MsgWalletActionBatch")
+	proto.RegisterType((*WalletBatchAction)(nil), "agoric.swingset.WalletBatchAction")
+	proto.RegisterType((*MsgWalletActionBatchResponse)(nil), "agoric.swingset.MsgWalletActionBatchResponse")
+	proto.RegisterType((*MsgProvision)(nil), "agoric.swingset.MsgProvision")
+	proto.RegisterType((*MsgProvisionResponse)(nil), "agoric.swingset.MsgProvisionResponse")
+	proto.RegisterType((*MsgUpdateEgressPowerFlags)(nil), "agoric.swingset.MsgUpdateEgressPowerFlags")
+	proto.RegisterType((*MsgUpdateEgressPowerFlagsResponse)(nil), "agoric.swingset.MsgUpdateEgressPowerFlagsResponse")
+	proto.RegisterType((*MsgDeprovision)(nil), "agoric.swingset.MsgDeprovision")
+	proto.RegisterType((*MsgDeprovisionResponse)(nil), "agoric.swingset.MsgDeprovisionResponse")
+	proto.RegisterType((*MsgInstallBundle)(nil), "agoric.swingset.MsgInstallBundle")
+	proto.RegisterType((*MsgInstallBundleResponse)(nil), "agoric.swingset.MsgInstallBundleResponse")
+	proto.RegisterType((*MsgCoreEval)(nil), "agoric.swingset.MsgCoreEval")
+	proto.RegisterType((*CoreEvalBundle)(nil), "agoric.swingset.CoreEvalBundle")
+	proto.RegisterType((*MsgCoreEvalResponse)(nil), "agoric.swingset.MsgCoreEvalResponse")
+	proto.RegisterType((*MsgBeginBundleUpload)(nil), "agoric.swingset.MsgBeginBundleUpload")
+	proto.RegisterType((*MsgBeginBundleUploadResponse)(nil), "agoric.swingset.MsgBeginBundleUploadResponse")
+	proto.RegisterType((*MsgUploadBundleChunk)(nil), "agoric.swingset.MsgUploadBundleChunk")
+	proto.RegisterType((*MsgUploadBundleChunkResponse)(nil), "agoric.swingset.MsgUploadBundleChunkResponse")
+	proto.RegisterType((*MsgFinishBundleUpload)(nil), "agoric.swingset.MsgFinishBundleUpload")
+	proto.RegisterType((*MsgFinishBundleUploadResponse)(nil), "agoric.swingset.MsgFinishBundleUploadResponse")
+	proto.RegisterType((*MsgSetInboundPause)(nil), "agoric.swingset.MsgSetInboundPause")
+	proto.RegisterType((*MsgSetInboundPauseResponse)(nil), "agoric.swingset.MsgSetInboundPauseResponse")
+	proto.RegisterType((*MsgPurgeInboundQueue)(nil), "agoric.swingset.MsgPurgeInboundQueue")
+	proto.RegisterType((*InboundQueuePurgeCriteria)(nil), "agoric.swingset.InboundQueuePurgeCriteria")
+	proto.RegisterType((*MsgPurgeInboundQueueResponse)(nil), "agoric.swingset.MsgPurgeInboundQueueResponse")
+}
+
+func init() { proto.RegisterFile("agoric/swingset/msgs.proto", fileDescriptor_788baa062b181a57) }
+
+var fileDescriptor_788baa062b181a57 = []byte{
+	// 1880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4f, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0xcf, 0xd8, 0xb1, 0xfd, 0xc6, 0x7f, 0xe2, 0xc6, 0x49, 0xc6, 0xbd, 0xb1, 0xdb, 0xae,
+	0x10, 0x62, 0x36, 0xb2, 0x2d, 0x1c, 0x0e, 0x28, 0x41, 0x02, 0x4f, 0xb2, 0x2b, 0x82, 0xe4, 0xe0,
+	0x2d, 0x6f, 0xb4, 0xd2, 0x6a, 0xd1, 0x6c, 0x7b, 0xba, 0xd2, 0xd3, 0xf2, 0x4c, 0x77, 0x6f, 0x57,
+	0x8f, 0x63, 0xef, 0x0d, 0x2e, 0x5c, 0xe1, 0x0b, 0x20, 0x38, 0x71, 0x44, 0x7c, 0x08, 0xa4, 0x3d,
+	0x41, 0x0e, 0x1c, 0x10, 0x48, 0x0d, 0x72, 0x2e, 0x68, 0x8e, 0x73, 0x84, 0x0b, 0xaa, 0x3f, 0x5d,
+	0xd5, 0xd3, 0x3d, 0x5e, 0x0f, 0x4b, 0xd6, 0x2b, 0x38, 0xd9, 0xf5, 0x7b, 0xaf, 0x5e, 0xbd, 0xfa,
+	0xbd, 0xf7, 0xaa, 0x5e, 0xd7, 0x80, 0xe5, 0x78, 0x61, 0xec, 0xb7, 0x76, 0xe8, 0x4b, 0x3f, 0xf0,
+	0x28, 0x49, 0x76, 0xba, 0xd4, 0xa3, 0xdb, 0x51, 0x1c, 0x26, 0xa1, 0xb9, 0x28, 0x64, 0xdb, 0x99,
+	0xcc, 0x5a, 0xf6, 0x42, 0x2f, 0xe4, 0xb2, 0x1d, 0xf6, 0x9f, 0x50, 0xb3, 0xd6, 0x8a, 0x26, 0xb2,
+	0x7f, 0x84, 0x1c, 0xfd, 0xb2, 0x02, 0x4b, 0xfb, 0xd4, 0x7b, 0x42, 0x3a, 0xfe, 0x09, 0x89, 0x9f,
+	0x06, 0x47, 0x61, 0x2f, 0x70, 0xcd, 0x47, 0x30, 0xd3, 0x25, 0x94, 0x3a, 0x1e, 0xa1, 0x75, 0x63,
+	0xbd, 0xba, 0x39, 0xdb, 0xb0, 0xfb, 0xa9, 0xad, 0xb0, 0x41, 0x6a, 0x2f, 0x9e, 0x39, 0xdd, 0xce,
+	0x43, 0x94, 0x21, 0x08, 0x2b, 0xa1, 0x79, 0x1f, 0x26, 0x83, 0x5e, 0x97, 0xd6, 0x2b, 0xeb, 0xd5,
+	0xcd, 0xc9, 0xc6, 0xad, 0x7e, 0x6a, 0xf3, 0xf1, 0x20, 0xb5, 0x6b, 0x62, 0x12, 0x1b, 0x21, 0xcc,
+	0x41, 0xf3, 0x1e, 0x54, 0x9d, 0xd6, 0x71, 0xbd, 0xba, 0x6e, 0x6c, 0x4e, 0x36, 0x6e, 0xf4, 0x53,
+	0x9b, 0x0d, 0x07, 0xa9, 0x0d, 0x42, 0xd5, 0x69, 0x1d, 0x23, 0xcc, 0x20, 0x33, 0x82, 0x59, 0xda,
+	0x3b, 0xea, 0xfa, 0x49, 0x42, 0xe2, 0xfa, 0xe4, 0xba, 0xb1, 0x39, 0xd7, 0xc0, 0xfd, 0xd4, 0xd6,
+	0xe0, 0x20, 0xb5, 0xaf, 0x8b, 0x49, 0x0a, 0x42, 0xff, 0x4c, 0xed, 0x2d, 0xcf, 0x4f, 0xda, 0xbd,
+	0xa3, 0xed, 0x56, 0xd8, 0xdd, 0x69, 0x85, 0xb4, 0x1b, 0x52, 0xf9, 0x67, 0x8b, 0xba, 0xc7, 0x3b,
+	0xc9, 0x59, 0x44, 0xe8, 0xf6, 0x5e, 0xab, 0xb5, 0xe7, 0xba, 0x31, 0xa1, 0x14, 0x6b, 0x7b, 0x0f,
+	0x27, 0xff, 0xf1, 0x2b, 0x7b, 0x02, 0xbd, 0x05, 0x2b, 0x25, 0x7e, 0x30, 0xa1, 0x51, 0x18, 0x50,
+	0x82, 0x7e, 0x61, 0xc0, 0xe2, 0x3e, 0xf5, 0x3e, 0x70, 0x3a, 0x1d, 0x92, 0xec, 0xb5, 0x12, 0x3f,
+	0x0c, 0xcc, 0x8f, 0x61, 0x2a, 0x7c, 0x19, 0x90, 0xb8, 0x6e, 0x70, 0x27, 0x7f, 0xd8, 0x4f, 0x6d,
+	0x01, 0x0c, 0x52, 0x7b, 0x4e, 0x38, 0xc8, 0x87, 0x5f, 0xc0, 0x39, 0x61, 0xc7, 0xbc, 0x09, 0xd7,
+	0x1c, 0xbe, 0x56, 0xbd, 0xb2, 0x6e, 0x6c, 0xce, 0x62, 0x39, 0x92, 0x0e, 0xaf, 0xc0, 0xad, 0x82,
+	0x4b, 0xca, 0xdd, 0x5f, 0x1b, 0xb0, 0xac, 0x64, 0x87, 0x11, 0x09, 0xdc, 0x2b, 0xf3, 0x79, 0x03,
+	0xe6, 0x28, 0x5b, 0xb0, 0x39, 0xe4, 0x79, 0x8d, 0x6a, 0x27, 0xa4, 0xfb, 0x6b, 0x70, 0x7b, 0x94,
+	0x8b, 0x6a, 0x0f, 0x3f, 0xab, 0xc2, 0x82, 0x52, 0xf8, 0xd1, 0x8b, 0x17, 0x24, 0xbe, 0x02, 0xef,
+	0xbf, 0x03, 0x33, 0x21, 0x5b, 0xaa, 0xe9, 0xbb, 0xc2, 0xf3, 0xc6, 0x6a, 0x3f, 0xb5, 0xa7, 0x39,
+	0xf6, 0xd4, 0x1d, 0xa4, 0xf6, 0x82, 0x5c, 0x46, 0x00, 0x08, 0x67, 0x22, 0xf3, 0x7d, 0x58, 0xf4,
+	0x83, 0x13, 0x3f, 0x71, 0xd8, 0x26, 0x9a, 0x34, 0x22, 0x2d, 0x9e, 0xeb, 0xb3, 0x8d, 0xfb, 0xfd,
+	0xd4, 0x5e, 0xd0, 0xa2, 0xc3, 0x88, 0xb4, 0x06, 0xa9, 0x7d, 0x43, 0xd8, 0x19, 0xc6, 0x11, 0x2e,
+	0x28, 0xb2, 0xfa, 0x8c, 0xe2, 0x30, 0x0a, 0xa9, 0xd3, 0xe1, 0xb5, 0x20, 0xeb, 0x33, 0xc3, 0x74,
+	0x7d, 0x66, 0x08, 0xc2, 0x4a, 0x68, 0x7e, 0x1f, 0x40, 0x6c, 0xc6, 0x89, 0x3d, 0x5a, 0x9f, 0xe2,
+	0xd3, 0x37, 0x58, 0x29, 0x71, 0x74, 0x2f, 0xf6, 0xa8, 0x2e, 0x25, 0x05, 0x21, 0xac, 0xc5, 0x32,
+	0x52, 0x75, 0xb8, 0x39, 0x1c, 0x08, 0x15, 0xa3, 0xbf, 0xe5, 0xf3, 0x4c, 0xc4, 0xaf, 0xe1, 0x24,
+	0xad, 0xf6, 0x15, 0x44, 0xaa, 0x09, 0xd3, 0x22, 0xc3, 0xc4, 0xf9, 0x53, 0xdb, 0x45, 0xdb, 0x85,
+	0x83, 0x72, 0x5b, 0xb8, 0xc5, 0x1d, 0x92, 0xbe, 0x6d, 0x7c, 0x96, 0xda, 0x13, 0x2c, 0xa0, 0x72,
+	0xaa, 0x0e, 0xa8, 0x04, 0x10, 0xce, 0x44, 0x72, 0xef, 0x67, 0xb0, 0x54, 0x32, 0x63, 0x3e, 0x50,
+	0x75, 0x69, 0x70, 0x52, 0xdf, 0xea, 0xa7, 0xb6, 0x44, 0x06, 0xa9, 0x3d, 0x9f, 0xb7, 0x88, 0xb2,
+	0xa2, 0x35, 0x77, 0x60, 0x8a, 0x17, 0x01, 0xcf, 0xab, 0x99, 0xc6, 0x0a, 0xa3, 0x84, 0x03, 0x9a,
+	0x12, 0x3e, 0x44, 0x58, 0xc0, 0x43, 0x05, 0x92, 0xe3, 0x56, 0x91, 0xff, 0x93, 0x2a, 0xcc, 0xed,
+	0x53, 0xef, 0x20, 0x0e, 0x4f, 0x7c, 0xca, 0x56, 0x78, 0x04, 0x33, 0x81, 0xdf, 0x3a, 0x0e, 0x9c,
+	0x2e, 0xa9, 0x1b, 0x3a, 0x59, 0x32, 0x4c, 0x27, 0x4b, 0x86, 0x20, 0xac, 0x84, 0x66, 0x1b, 0xa6,
+	0x1d, 0xc1, 0x30, 0x77, 0x70, 0xae, 0xf1, 0x8c, 0xf3, 0x24, 0xa0, 0x1c, 0x4f, 0x02, 0xf8, 0x02,
+	0x71, 0xcb, 0x6c, 0x99, 0x18, 0x6a, 0x51, 0xf8, 0x92, 0xc4, 0xcd, 0x17, 0x1d, 0xc7, 0xa3, 0xf5,
+	0x2a, 0xbf, 0x76, 0xbe, 0x75, 0x9e, 0xda, 0x70, 0xc0, 0xe0, 0x77, 0x19, 0xda, 0x4f, 0x6d, 0x88,
+	0xd4, 0x68, 0x90, 0xda, 0x4b, 0x32, 0xcd, 0x15, 0x86, 0x70, 0x4e, 0xe1, 0x2b, 0xbb, 0x34, 0x6e,
+	0xc2, 0x72, 0x3e, 0x04, 0x2a, 0x36, 0x7f, 0xaa, 0xf2, 0xdb, 0xe4, 0x79, 0xe4, 0x3a, 0x09, 0x79,
+	0xc7, 0x63, 0x93, 0xf5, 0xe6, 0xf2, 0x5c, 0x1b, 0x5f, 0x2e, 0xd7, 0x47, 0xb0, 0xe8, 0xb8, 0x6e,
+	0x33, 0xcf, 0x77, 0x85, 0xf3, 0xfd, 0xf0, 0x3c, 0xb5, 0xe7, 0xf7, 0x5c, 0x77, 0x88, 0xf2, 0x79,
+	0x27, 0x0f, 0x0c, 0x52, 0x7b, 0x59, 0x39, 0x72, 0x90, 0x23, 0x7e, 0x58, 0xcd, 0xfc, 0x04, 0xcc,
+	0x98, 0x74, 0xc3, 0x13, 0xd2, 0x2c, 0x87, 0xf5, 0xf1, 0x79, 0x6a, 0x5f, 0xc7, 0x5c, 0x3a, 0xb4,
+	0xd2, 0xf5, 0xb8, 0x80, 0x0d, 0x52, 0xfb, 0x96, 0x58, 0xac, 0x28, 0x41, 0xb8, 0xa4, 0xfc, 0x95,
+	0x85, 0xfb, 0x25, 0x6c, 0x5c, 0x18, 0xd5, 0x2c, 0xf6, 0xc5, 0xfc, 0x36, 0xde, 0x40, 0x7e, 0xa3,
+	0xdf, 0x54, 0xf8, 0x65, 0xf8, 0x84, 0x44, 0xaa, 0xda, 0xaf, 0x2e, 0x89, 0x1e, 0xc0, 0xb5, 0x98,
+	0x38, 0x34, 0xbb, 0xcc, 0xc5, 0x71, 0x27, 0x10, 0x7d, 0xdc, 0x89, 0x31, 0xc2, 0x52, 0x30, 0x1c,
+	0xa2, 0xea, 0xd5, 0x85, 0x48, 0x5c, 0x56, 0x39, 0xa2, 0x54, 0x4d, 0xfe, 0xa5, 0x02, 0xd7, 0xf7,
+	0xa9, 0xf7, 0x34, 0xa0, 0x89, 0xd3, 0xe9, 0x34, 0x7a, 0x81, 0xdb, 0x21, 0x6c, 0x6f, 0x47, 0xfc,
+	0xbf, 0xfc, 0x51, 0x2e, 0x10, 0xbd, 0x37, 0x31, 0x46, 0x58, 0x0a, 0x86, 0xf7, 0x56, 0xb9, 0x82,
+	0xbd, 0x99, 0x1f, 0xc1, 0x52, 0x2b, 0xec, 0x46, 0x0c, 0x26, 0x6e, 0x53, 0x7a, 0x2c, 0x58, 0xdd,
+	0x61, 0xe5, 0xa4, 0x85, 0x8d, 0xcc, 0x77, 0x59, 0x4e, 0x45, 0x09, 0xc2, 0x25, 0x65, 0x73, 0x0f,
+	0x96, 0x7a, 0x41, 0xce, 0x3e, 0xf5, 0x3f, 0x25, 0xbc, 0xac, 0xaa, 0x8d, 0x65, 0x66, 0x3d, 0x2f,
+	0x3c, 0xf4, 0x3f, 0x25, 0xb8, 0x84, 0x20, 0x0b, 0xea, 0x45, 0x6e, 0x15, 0xf1, 0x3f, 0xad, 0x40,
+	0x6d, 0x9f, 0x7a, 0x8f, 0xc3, 0x98, 0xbc, 0x73, 0xe2, 0x74, 0xcc, 0xef, 0xc1, 0xac, 0xd3, 0x4b,
+	0xda, 0x61, 0xec, 0x27, 0x67, 0x75, 0x43, 0xb7, 0x25, 0x0a, 0xd4, 0xf4, 0x29, 0x08, 0x61, 0x2d,
+	0x36, 0x9f, 0xc1, 0x14, 0x39, 0x71, 0x3a, 0xd9, 0xcd, 0xbf, 0x52, 0xba, 0xf9, 0xb3, 0xa5, 0x1a,
+	0xab, 0xf2, 0xc2, 0x17, 0xfa, 0xfa, 0xa6, 0xe5, 0x43, 0x84, 0x05, 0x6c, 0x7e, 0x04, 0xd3, 0x82,
+	0x52, 0x71, 0x6c, 0xd5, 0x76, 0xed, 0x8b, 0x2d, 0x72, 0x3d, 0xdd, 0x48, 0xc8, 0x79, 0xba, 0xde,
+	0x24, 0x80, 0x70, 0x26, 0x92, 0x79, 0xf9, 0x3b, 0x03, 0x16, 0x86, 0x8d, 0x8c, 0x0e, 0xaa, 0xf1,
+	0xa5, 0x06, 0xb5, 0xf2, 0x1f, 0x05, 0xf5, 0x06, 0x7c, 0x2d, 0x17, 0x37, 0x15, 0xcf, 0x3f, 0x54,
+	0xf8, 0xad, 0xd7, 0x20, 0x9e, 0x1f, 0x88, 0xc5, 0x9e, 0x47, 0x9d, 0xd0, 0x71, 0x87, 0xeb, 0xc2,
+	0xb8, 0x8a, 0xba, 0x78, 0x02, 0x35, 0xc1, 0x5b, 0xb3, 0xed, 0xd0, 0xb6, 0x3c, 0x9f, 0xee, 0xb0,
+	0xd3, 0x55, 0xc0, 0x3f, 0x70, 0x68, 0x5b, 0x9f, 0xae, 0x1a, 0x43, 0x38, 0xa7, 0x60, 0x3e, 0x82,
+	0xc5, 0x22, 0x51, 0x55, 0x4e, 0x94, 0xc9, 0x7a, 0xf7, 0x02, 0x4d, 0x85, 0xf1, 0x9b, 0x28, 0x1e,
+	0xd1, 0xe9, 0x95, 0xf8, 0x54, 0x84, 0xff, 0x5e, 0x10, 0x2e, 0x50, 0xa1, 0xf1, 0xb8, 0xdd, 0x0b,
+	0x8e, 0xff, 0x67, 0x09, 0x7f, 0x02, 0xb5, 0x16, 0xdb, 0x40, 0xd3, 0x0f, 0x5c, 0x72, 0xca, 0xc9,
+	0x9e, 0x17, 0x56, 0x38, 0xfc, 0x94, 0xa1, 0xda, 0x8a, 0xc6, 0x10, 0xce, 0x29, 0xb0, 0x8e, 0x9a,
+	0x8f, 0x64, 0x07, 0xc0, 0x3b, 0x6a, 0x0e, 0xe8, 0x3a, 0xe7, 0x43, 0x84, 0x05, 0x2c, 0x79, 0x2e,
+	0xd1, 0xa8, 0x78, 0xfe, 0xa3, 0x01, 0x37, 0xf6, 0xa9, 0xf7, 0xae, 0x1f, 0xf8, 0xb4, 0xfd, 0xff,
+	0x90, 0xd9, 0xc8, 0x86, 0xd5, 0x91, 0x1b, 0x52, 0x5b, 0xfe, 0xad, 0x01, 0xe6, 0x3e, 0xf5, 0x0e,
+	0x49, 0x22, 0x9f, 0x3c, 0x0e, 0x9c, 0x1e, 0x25, 0xff, 0xfd, 0x11, 0x8d, 0x61, 0x2a, 0x62, 0x96,
+	0xb8, 0xe3, 0xb5, 0xdd, 0xd5, 0xd2, 0x81, 0x9a, 0x5f, 0x4e, 0x1f, 0xd3, 0x7c, 0x8e, 0x0e, 0x1f,
+	0x1f, 0x22, 0x2c, 0x60, 0x79, 0x90, 0xde, 0x06, 0xab, 0xec, 0xb0, 0xda, 0xcf, 0xbf, 0xc4, 0x17,
+	0xe9, 0x41, 0x2f, 0xf6, 0x88, 0x54, 0x78, 0xaf, 0x47, 0x7a, 0x6f, 0x60, 0x47, 0x3b, 0x30, 0xf5,
+	0x09, 0xb3, 0x24, 0x43, 0xc1, 0xb3, 0x8d, 0x03, 0xda, 0x5d, 0x3e, 0x44, 0x58, 0xc0, 0xe6, 0x31,
+	0xcc, 0xb4, 0x62, 0x3f, 0x21, 0xb1, 0xef, 0xf0, 0x0c, 0xaf, 0xed, 0xbe, 0x7d, 0x11, 0x0b, 0xdc,
+	0x45, 0xee, 0xf3, 0x63, 0x39, 0xa3, 0x71, 0x47, 0x52, 0xa2, 0x6c, 0xe8, 0xcf, 0xb7, 0x0c, 0x41,
+	0x58, 0x09, 0x25, 0x37, 0xaf, 0x2a, 0xb0, 0x72, 0xa1, 0x49, 0x96, 0x52, 0x34, 0x71, 0xe2, 0x44,
+	0x56, 0x9d, 0xc1, 0x9f, 0xe2, 0x78, 0x4a, 0x71, 0xb8, 0x50, 0x75, 0x1a, 0x43, 0x38, 0xa7, 0x60,
+	0x7e, 0x17, 0x66, 0xd9, 0xf3, 0x8e, 0xb0, 0x51, 0xe1, 0x36, 0xf8, 0x67, 0x26, 0x09, 0xdc, 0xcc,
+	0x82, 0xf4, 0x33, 0x43, 0x10, 0x56, 0x42, 0xe6, 0x83, 0xf8, 0x1e, 0x6e, 0xb2, 0xe4, 0x97, 0x4f,
+	0x24, 0xdc, 0x07, 0x01, 0xbf, 0x7f, 0x16, 0x11, 0xed, 0x83, 0xc6, 0x10, 0xce, 0x29, 0xb0, 0xae,
+	0x8d, 0x92, 0xc0, 0x95, 0xcd, 0xbf, 0xec, 0xda, 0x04, 0xa2, 0xbb, 0x36, 0x31, 0x46, 0x58, 0x0a,
+	0xcc, 0x6f, 0xc3, 0x74, 0x72, 0x2a, 0xaa, 0x69, 0x4a, 0xcf, 0x4a, 0x4e, 0x65, 0x25, 0xc9, 0x59,
+	0x62, 0x8c, 0xb0, 0x14, 0xa0, 0x43, 0x7e, 0x66, 0x94, 0xf2, 0x49, 0x75, 0xfb, 0x0f, 0xe0, 0x5a,
+	0xc4, 0x84, 0xae, 0xe4, 0x93, 0x1b, 0x15, 0x88, 0x36, 0x2a, 0xc6, 0x08, 0x4b, 0xc1, 0xee, 0x5f,
+	0x6b, 0x50, 0xdd, 0xa7, 0x9e, 0xf9, 0x63, 0x98, 0x1f, 0x6e, 0x47, 0x37, 0x4a, 0x19, 0x52, 0xec,
+	0xaa, 0xac, 0x6f, 0x5e, 0xaa, 0xa2, 0x7c, 0xfb, 0x18, 0x16, 0x0a, 0xef, 0xbd, 0x68, 0xd4, 0xe4,
+	0x61, 0x1d, 0xeb, 0xed, 0xcb, 0x75, 0xd4, 0x0a, 0x1f, 0xc2, 0xdc, 0xd0, 0x9b, 0xe8, 0xfa, 0xa8,
+	0xb9, 0x79, 0x0d, 0x6b, 0xf3, 0x32, 0x0d, 0x65, 0xdb, 0x87, 0xa5, 0xd2, 0xeb, 0xa0, 0x79, 0xf7,
+	0xe2, 0xe9, 0x39, 0x35, 0x6b, 0x6b, 0x2c, 0x35, 0xb5, 0xd4, 0x07, 0x50, 0xcb, 0xbf, 0x33, 0xda,
+	0x17, 0xcf, 0xe6, 0x0a, 0xd6, 0xbd, 0x4b, 0x14, 0xca, 0x7b, 0xc8, 0x3f, 0x8e, 0xdd, 0xbd, 0x8c,
+	0x02, 0xae, 0x66, 0x6d, 0x8d, 0xa5, 0xa6, 0x96, 0x7a, 0x0f, 0x66, 0xf5, 0x53, 0xd0, 0xea, 0xa8,
+	0xb9, 0x4a, 0x6c, 0xdd, 0xfd, 0x5c, 0xb1, 0x32, 0x79, 0x0a, 0x37, 0x2f, 0x78, 0xc1, 0x18, 0x99,
+	0x23, 0xa3, 0x75, 0xad, 0xdd, 0xf1, 0x75, 0xf3, 0x01, 0xc9, 0x7f, 0xeb, 0xda, 0xa3, 0x53, 0x52,
+	0x29, 0x58, 0xf7, 0x2e, 0x51, 0x50, 0x86, 0x9f, 0xc1, 0x8c, 0xfa, 0x0e, 0xb9, 0x3d, 0x6a, 0x52,
+	0x26, 0xb5, 0xbe, 0xfe, 0x79, 0xd2, 0x7c, 0x80, 0xcb, 0x7d, 0xf0, 0x48, 0x7a, 0x4b, 0x6a, 0xd6,
+	0xd6, 0x58, 0x6a, 0xf9, 0xa5, 0xca, 0x1d, 0xe0, 0xdd, 0xd1, 0xe4, 0x16, 0xd4, 0xac, 0xad, 0xb1,
+	0xd4, 0xd4, 0x52, 0x1d, 0x30, 0x47, 0x34, 0x41, 0xdf, 0x18, 0x65, 0xa4, 0xac, 0x67, 0x6d, 0x8f,
+	0xa7, 0xa7, 0x56, 0x6b, 0xc1, 0x62, 0xb1, 0xff, 0xb8, 0x33, 0xca, 0x44, 0x41, 0xc9, 0xba, 0x3f,
+	0x86, 0x52, 0x9e, 0xbd, 0x72, 0x53, 0x30, 0xba, 0x0e, 0x8a, 0x6a, 0xd6, 0xd6, 0x58, 0x6a, 0xd9,
+	0x52, 0x8d, 0xe7, 0x9f, 0x9d, 0xaf, 0x19, 0xaf, 0xce, 0xd7, 0x8c, 0xbf, 0x9f, 0xaf, 0x19, 0x3f,
+	0x7f, 0xbd, 0x36, 0xf1, 0xea, 0xf5, 0xda, 0xc4, 0x9f, 0x5f, 0xaf, 0x4d, 0x7c, 0xf8, 0x28, 0xd7,
+	0x0e, 0xee, 0x89, 0xdf, 0xeb, 0x84, 0x65, 0xde, 0x0e, 0x7a, 0x61, 0xc7, 0x09, 0xbc, 0xac, 0x4f,
+	0x3c, 0xd5, 0x3f, 0xe5, 0xf1, 0x3e, 0xf1, 0xe8, 0x1a, 0xff, 0x21, 0xef, 0xc1, 0xbf, 0x07, 0x00,
+	0xa4, 0xbc, 0x21, 0x91, 0x2d, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Install a JavaScript sources bundle on the chain's SwingSet controller.
+	InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error)
+	// Send inbound messages.
+	DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error)
+	// Perform a low-privilege wallet action.
+	WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error)
+	// Perform a wallet action that spends assets.
+	WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error)
+	// Execute a smart wallet offer given as structured CapData.
+	WalletOffer(ctx context.Context, in *MsgWalletOffer, opts ...grpc.CallOption) (*MsgWalletOfferResponse, error)
+	// Perform an ordered list of wallet actions as a single inbound message.
+	WalletActionBatch(ctx context.Context, in *MsgWalletActionBatch, opts ...grpc.CallOption) (*MsgWalletActionBatchResponse, error)
+	// Provision a new endpoint.
+	Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error)
+	// Add or remove power flags of a provisioned endpoint.
+	UpdateEgressPowerFlags(ctx context.Context, in *MsgUpdateEgressPowerFlags, opts ...grpc.CallOption) (*MsgUpdateEgressPowerFlagsResponse, error)
+	// Deprovision an endpoint.
+	Deprovision(ctx context.Context, in *MsgDeprovision, opts ...grpc.CallOption) (*MsgDeprovisionResponse, error)
+	// Evaluate code in the SwingSet core, installing any attached bundles first.
+	// Only executable by the governance authority.
+	CoreEval(ctx context.Context, in *MsgCoreEval, opts ...grpc.CallOption) (*MsgCoreEvalResponse, error)
+	// Begin uploading a bundle too large to fit in a single transaction.
+	BeginBundleUpload(ctx context.Context, in *MsgBeginBundleUpload, opts ...grpc.CallOption) (*MsgBeginBundleUploadResponse, error)
+	// Upload the next chunk of a pending bundle upload.
+	UploadBundleChunk(ctx context.Context, in *MsgUploadBundleChunk, opts ...grpc.CallOption) (*MsgUploadBundleChunkResponse, error)
+	// Finish a bundle upload, installing the assembled bundle.
+	FinishBundleUpload(ctx context.Context, in *MsgFinishBundleUpload, opts ...grpc.CallOption) (*MsgFinishBundleUploadResponse, error)
+	// Pause or resume inbound messages.
+	// Only executable by the governance authority.
+	SetInboundPause(ctx context.Context, in *MsgSetInboundPause, opts ...grpc.CallOption) (*MsgSetInboundPauseResponse, error)
+	// Remove poisoned records from an inbound queue, archiving them.
+	// Only executable by the governance authority.
+	PurgeInboundQueue(ctx context.Context, in *MsgPurgeInboundQueue, opts ...grpc.CallOption) (*MsgPurgeInboundQueueResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error) {
+	out := new(MsgInstallBundleResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/InstallBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error) {
+	out := new(MsgDeliverInboundResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/DeliverInbound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error) {
+	out := new(MsgWalletActionResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error) {
+	out := new(MsgWalletSpendActionResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletSpendAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WalletOffer(ctx context.Context, in *MsgWalletOffer, opts ...grpc.CallOption) (*MsgWalletOfferResponse, error) {
+	out := new(MsgWalletOfferResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WalletActionBatch(ctx context.Context, in *MsgWalletActionBatch, opts ...grpc.CallOption) (*MsgWalletActionBatchResponse, error) {
+	out := new(MsgWalletActionBatchResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletActionBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error) {
+	out := new(MsgProvisionResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/Provision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEgressPowerFlags(ctx context.Context, in *MsgUpdateEgressPowerFlags, opts ...grpc.CallOption) (*MsgUpdateEgressPowerFlagsResponse, error) {
+	out := new(MsgUpdateEgressPowerFlagsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/UpdateEgressPowerFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Deprovision(ctx context.Context, in *MsgDeprovision, opts ...grpc.CallOption) (*MsgDeprovisionResponse, error) {
+	out := new(MsgDeprovisionResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/Deprovision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CoreEval(ctx context.Context, in *MsgCoreEval, opts ...grpc.CallOption) (*MsgCoreEvalResponse, error) {
+	out := new(MsgCoreEvalResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/CoreEval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BeginBundleUpload(ctx context.Context, in *MsgBeginBundleUpload, opts ...grpc.CallOption) (*MsgBeginBundleUploadResponse, error) {
+	out := new(MsgBeginBundleUploadResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/BeginBundleUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UploadBundleChunk(ctx context.Context, in *MsgUploadBundleChunk, opts ...grpc.CallOption) (*MsgUploadBundleChunkResponse, error) {
+	out := new(MsgUploadBundleChunkResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/UploadBundleChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinishBundleUpload(ctx context.Context, in *MsgFinishBundleUpload, opts ...grpc.CallOption) (*MsgFinishBundleUploadResponse, error) {
+	out := new(MsgFinishBundleUploadResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/FinishBundleUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetInboundPause(ctx context.Context, in *MsgSetInboundPause, opts ...grpc.CallOption) (*MsgSetInboundPauseResponse, error) {
+	out := new(MsgSetInboundPauseResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/SetInboundPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PurgeInboundQueue(ctx context.Context, in *MsgPurgeInboundQueue, opts ...grpc.CallOption) (*MsgPurgeInboundQueueResponse, error) {
+	out := new(MsgPurgeInboundQueueResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/PurgeInboundQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Install a JavaScript sources bundle on the chain's SwingSet controller.
+	InstallBundle(context.Context, *MsgInstallBundle) (*MsgInstallBundleResponse, error)
+	// Send inbound messages.
+	DeliverInbound(context.Context, *MsgDeliverInbound) (*MsgDeliverInboundResponse, error)
+	// Perform a low-privilege wallet action.
+	WalletAction(context.Context, *MsgWalletAction) (*MsgWalletActionResponse, error)
+	// Perform a wallet action that spends assets.
+	WalletSpendAction(context.Context, *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error)
+	// Execute a smart wallet offer given as structured CapData.
+	WalletOffer(context.Context, *MsgWalletOffer) (*MsgWalletOfferResponse, error)
+	// Perform an ordered list of wallet actions as a single inbound message.
+	WalletActionBatch(context.Context, *MsgWalletActionBatch) (*MsgWalletActionBatchResponse, error)
+	// Provision a new endpoint.
+	Provision(context.Context, *MsgProvision) (*MsgProvisionResponse, error)
+	// Add or remove power flags of a provisioned endpoint.
+	UpdateEgressPowerFlags(context.Context, *MsgUpdateEgressPowerFlags) (*MsgUpdateEgressPowerFlagsResponse, error)
+	// Deprovision an endpoint.
+	Deprovision(context.Context, *MsgDeprovision) (*MsgDeprovisionResponse, error)
+	// Evaluate code in the SwingSet core, installing any attached bundles first.
+	// Only executable by the governance authority.
+	CoreEval(context.Context, *MsgCoreEval) (*MsgCoreEvalResponse, error)
+	// Begin uploading a bundle too large to fit in a single transaction.
+	BeginBundleUpload(context.Context, *MsgBeginBundleUpload) (*MsgBeginBundleUploadResponse, error)
+	// Upload the next chunk of a pending bundle upload.
+	UploadBundleChunk(context.Context, *MsgUploadBundleChunk) (*MsgUploadBundleChunkResponse, error)
+	// Finish a bundle upload, installing the assembled bundle.
+	FinishBundleUpload(context.Context, *MsgFinishBundleUpload) (*MsgFinishBundleUploadResponse, error)
+	// Pause or resume inbound messages.
+	// Only executable by the governance authority.
+	SetInboundPause(context.Context, *MsgSetInboundPause) (*MsgSetInboundPauseResponse, error)
+	// Remove poisoned records from an inbound queue, archiving them.
+	// Only executable by the governance authority.
+	PurgeInboundQueue(context.Context, *MsgPurgeInboundQueue) (*MsgPurgeInboundQueueResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) InstallBundle(ctx context.Context, req *MsgInstallBundle) (*MsgInstallBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallBundle not implemented")
+}
+func (*UnimplementedMsgServer) DeliverInbound(ctx context.Context, req *MsgDeliverInbound) (*MsgDeliverInboundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliverInbound not implemented")
+}
+func (*UnimplementedMsgServer) WalletAction(ctx context.Context, req *MsgWalletAction) (*MsgWalletActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletAction not implemented")
+}
+func (*UnimplementedMsgServer) WalletSpendAction(ctx context.Context, req *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletSpendAction not implemented")
+}
+func (*UnimplementedMsgServer) WalletOffer(ctx context.Context, req *MsgWalletOffer) (*MsgWalletOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletOffer not implemented")
+}
+func (*UnimplementedMsgServer) WalletActionBatch(ctx context.Context, req *MsgWalletActionBatch) (*MsgWalletActionBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletActionBatch not implemented")
+}
+func (*UnimplementedMsgServer) Provision(ctx context.Context, req *MsgProvision) (*MsgProvisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Provision not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEgressPowerFlags(ctx context.Context, req *MsgUpdateEgressPowerFlags) (*MsgUpdateEgressPowerFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEgressPowerFlags not implemented")
+}
+func (*UnimplementedMsgServer) Deprovision(ctx context.Context, req *MsgDeprovision) (*MsgDeprovisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deprovision not implemented")
+}
+func (*UnimplementedMsgServer) CoreEval(ctx context.Context, req *MsgCoreEval) (*MsgCoreEvalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoreEval not implemented")
+}
+func (*UnimplementedMsgServer) BeginBundleUpload(ctx context.Context, req *MsgBeginBundleUpload) (*MsgBeginBundleUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginBundleUpload not implemented")
+}
+func (*UnimplementedMsgServer) UploadBundleChunk(ctx context.Context, req *MsgUploadBundleChunk) (*MsgUploadBundleChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadBundleChunk not implemented")
+}
+func (*UnimplementedMsgServer) FinishBundleUpload(ctx context.Context, req *MsgFinishBundleUpload) (*MsgFinishBundleUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishBundleUpload not implemented")
+}
+func (*UnimplementedMsgServer) SetInboundPause(ctx context.Context, req *MsgSetInboundPause) (*MsgSetInboundPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInboundPause not implemented")
+}
+func (*UnimplementedMsgServer) PurgeInboundQueue(ctx context.Context, req *MsgPurgeInboundQueue) (*MsgPurgeInboundQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeInboundQueue not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_InstallBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstallBundle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstallBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/InstallBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstallBundle(ctx, req.(*MsgInstallBundle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeliverInbound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeliverInbound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeliverInbound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/DeliverInbound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeliverInbound(ctx, req.(*MsgDeliverInbound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WalletAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWalletAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WalletAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/WalletAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WalletAction(ctx, req.(*MsgWalletAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WalletSpendAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWalletSpendAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WalletSpendAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/WalletSpendAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WalletSpendAction(ctx, req.(*MsgWalletSpendAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WalletOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWalletOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WalletOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/WalletOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WalletOffer(ctx, req.(*MsgWalletOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WalletActionBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWalletActionBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WalletActionBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/WalletActionBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WalletActionBatch(ctx, req.(*MsgWalletActionBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Provision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProvision)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Provision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/Provision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Provision(ctx, req.(*MsgProvision))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEgressPowerFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEgressPowerFlags)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEgressPowerFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/UpdateEgressPowerFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEgressPowerFlags(ctx, req.(*MsgUpdateEgressPowerFlags))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Deprovision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeprovision)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Deprovision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/Deprovision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deprovision(ctx, req.(*MsgDeprovision))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CoreEval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCoreEval)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CoreEval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/CoreEval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CoreEval(ctx, req.(*MsgCoreEval))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BeginBundleUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginBundleUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginBundleUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/BeginBundleUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginBundleUpload(ctx, req.(*MsgBeginBundleUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UploadBundleChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUploadBundleChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UploadBundleChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/UploadBundleChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UploadBundleChunk(ctx, req.(*MsgUploadBundleChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinishBundleUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinishBundleUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinishBundleUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/FinishBundleUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinishBundleUpload(ctx, req.(*MsgFinishBundleUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetInboundPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetInboundPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetInboundPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/SetInboundPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetInboundPause(ctx, req.(*MsgSetInboundPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurgeInboundQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurgeInboundQueue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurgeInboundQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/PurgeInboundQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurgeInboundQueue(ctx, req.(*MsgPurgeInboundQueue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.swingset.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InstallBundle",
+			Handler:    _Msg_InstallBundle_Handler,
+		},
+		{
+			MethodName: "DeliverInbound",
+			Handler:    _Msg_DeliverInbound_Handler,
+		},
+		{
+			MethodName: "WalletAction",
+			Handler:    _Msg_WalletAction_Handler,
+		},
+		{
+			MethodName: "WalletSpendAction",
+			Handler:    _Msg_WalletSpendAction_Handler,
+		},
+		{
+			MethodName: "WalletOffer",
+			Handler:    _Msg_WalletOffer_Handler,
+		},
+		{
+			MethodName: "WalletActionBatch",
+			Handler:    _Msg_WalletActionBatch_Handler,
+		},
+		{
+			MethodName: "Provision",
+			Handler:    _Msg_Provision_Handler,
+		},
+		{
+			MethodName: "UpdateEgressPowerFlags",
+			Handler:    _Msg_UpdateEgressPowerFlags_Handler,
+		},
+		{
+			MethodName: "Deprovision",
+			Handler:    _Msg_Deprovision_Handler,
+		},
+		{
+			MethodName: "CoreEval",
+			Handler:    _Msg_CoreEval_Handler,
+		},
+		{
+			MethodName: "BeginBundleUpload",
+			Handler:    _Msg_BeginBundleUpload_Handler,
+		},
+		{
+			MethodName: "UploadBundleChunk",
+			Handler:    _Msg_UploadBundleChunk_Handler,
+		},
+		{
+			MethodName: "FinishBundleUpload",
+			Handler:    _Msg_FinishBundleUpload_Handler,
+		},
+		{
+			MethodName: "SetInboundPause",
+			Handler:    _Msg_SetInboundPause_Handler,
+		},
+		{
+			MethodName: "PurgeInboundQueue",
+			Handler:    _Msg_PurgeInboundQueue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/swingset/msgs.proto",
+}
+
+func (m *MsgDeliverInbound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverInbound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverInbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Ack != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Ack))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Nums) > 0 {
+		dAtA2 := make([]byte, len(m.Nums)*10)
+		var j1 int
+		for _, num := range m.Nums {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintMsgs(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeliverInboundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverInboundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverInboundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletSpendAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletSpendAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletSpendAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendAction) > 0 {
+		i -= len(m.SpendAction)
+		copy(dAtA[i:], m.SpendAction)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SpendAction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletSpendActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletSpendActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletSpendActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OfferArgs) > 0 {
+		i -= len(m.OfferArgs)
+		copy(dAtA[i:], m.OfferArgs)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OfferArgs)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Proposal) > 0 {
+		i -= len(m.Proposal)
+		copy(dAtA[i:], m.Proposal)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Proposal)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InvitationSpec) > 0 {
+		i -= len(m.InvitationSpec)
+		copy(dAtA[i:], m.InvitationSpec)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvitationSpec)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OfferId) > 0 {
+		i -= len(m.OfferId)
+		copy(dAtA[i:], m.OfferId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OfferId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletActionBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletActionBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletActionBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WalletBatchAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WalletBatchAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WalletBatchAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Spend {
+		i--
+		if m.Spend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletActionBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletActionBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletActionBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PowerFlags) > 0 {
+		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PowerFlags[iNdEx])
+			copy(dAtA[i:], m.PowerFlags[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nickname) > 0 {
+		i -= len(m.Nickname)
+		copy(dAtA[i:], m.Nickname)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Nickname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProvisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEgressPowerFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEgressPowerFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEgressPowerFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RemovePowerFlags) > 0 {
+		for iNdEx := len(m.RemovePowerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovePowerFlags[iNdEx])
+			copy(dAtA[i:], m.RemovePowerFlags[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.RemovePowerFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AddPowerFlags) > 0 {
+		for iNdEx := len(m.AddPowerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddPowerFlags[iNdEx])
+			copy(dAtA[i:], m.AddPowerFlags[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.AddPowerFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEgressPowerFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEgressPowerFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEgressPowerFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PowerFlags) > 0 {
+		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PowerFlags[iNdEx])
+			copy(dAtA[i:], m.PowerFlags[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeprovision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeprovision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeprovision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeprovisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeprovisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeprovisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstallBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstallBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstallBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UncompressedSize != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CompressedBundle) > 0 {
+		i -= len(m.CompressedBundle)
+		copy(dAtA[i:], m.CompressedBundle)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CompressedBundle)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bundle) > 0 {
+		i -= len(m.Bundle)
+		copy(dAtA[i:], m.Bundle)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Bundle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstallBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstallBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstallBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCoreEval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCoreEval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCoreEval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bundles) > 0 {
+		for iNdEx := len(m.Bundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Evals) > 0 {
+		for iNdEx := len(m.Evals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoreEvalBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoreEvalBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoreEvalBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UncompressedSize != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CompressedBundle) > 0 {
+		i -= len(m.CompressedBundle)
+		copy(dAtA[i:], m.CompressedBundle)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CompressedBundle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCoreEvalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCoreEvalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCoreEvalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginBundleUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginBundleUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginBundleUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UncompressedSize != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CompressedSize != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.CompressedSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginBundleUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginBundleUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginBundleUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadBundleChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadBundleChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadBundleChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChunkIndex != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ChunkIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadBundleChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadBundleChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadBundleChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinishBundleUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinishBundleUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinishBundleUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinishBundleUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinishBundleUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinishBundleUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInboundPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInboundPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInboundPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInboundPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInboundPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInboundPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurgeInboundQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurgeInboundQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurgeInboundQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Criteria.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InboundQueuePurgeCriteria) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundQueuePurgeCriteria) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundQueuePurgeCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActionType) > 0 {
+		i -= len(m.ActionType)
+		copy(dAtA[i:], m.ActionType)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ActionType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndIndex != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EndIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartIndex != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.StartIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurgeInboundQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurgeInboundQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurgeInboundQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Purged != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Purged))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDeliverInbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, s := range m.Messages {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.Nums) > 0 {
+		l = 0
+		for _, e := range m.Nums {
+			l += sovMsgs(uint64(e))
+		}
+		n += 1 + sovMsgs(uint64(l)) + l
+	}
+	if m.Ack != 0 {
+		n += 1 + sovMsgs(uint64(m.Ack))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeliverInboundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWalletAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWalletActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWalletSpendAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.SpendAction)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWalletSpendActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWalletOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OfferId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.InvitationSpec)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Proposal)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OfferArgs)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWalletOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWalletActionBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WalletBatchAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Spend {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgWalletActionBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nickname)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.PowerFlags) > 0 {
+		for _, s := range m.PowerFlags {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateEgressPowerFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.AddPowerFlags) > 0 {
+		for _, s := range m.AddPowerFlags {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.RemovePowerFlags) > 0 {
+		for _, s := range m.RemovePowerFlags {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateEgressPowerFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PowerFlags) > 0 {
+		for _, s := range m.PowerFlags {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeprovision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeprovisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInstallBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bundle)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.CompressedBundle)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.UncompressedSize != 0 {
+		n += 1 + sovMsgs(uint64(m.UncompressedSize))
+	}
+	return n
+}
+
+func (m *MsgInstallBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCoreEval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Evals) > 0 {
+		for _, e := range m.Evals {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.Bundles) > 0 {
+		for _, e := range m.Bundles {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CoreEvalBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CompressedBundle)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.UncompressedSize != 0 {
+		n += 1 + sovMsgs(uint64(m.UncompressedSize))
+	}
+	return n
+}
+
+func (m *MsgCoreEvalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBeginBundleUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.CompressedSize != 0 {
+		n += 1 + sovMsgs(uint64(m.CompressedSize))
+	}
+	if m.UncompressedSize != 0 {
+		n += 1 + sovMsgs(uint64(m.UncompressedSize))
+	}
+	return n
+}
+
+func (m *MsgBeginBundleUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUploadBundleChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ChunkIndex != 0 {
+		n += 1 + sovMsgs(uint64(m.ChunkIndex))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUploadBundleChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinishBundleUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinishBundleUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetInboundPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Pause.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgSetInboundPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPurgeInboundQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Criteria.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *InboundQueuePurgeCriteria) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartIndex != 0 {
+		n += 1 + sovMsgs(uint64(m.StartIndex))
+	}
+	if m.EndIndex != 0 {
+		n += 1 + sovMsgs(uint64(m.EndIndex))
+	}
+	l = len(m.ActionType)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPurgeInboundQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Purged != 0 {
+		n += 1 + sovMsgs(uint64(m.Purged))
+	}
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeliverInbound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeliverInbound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nums = append(m.Nums, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nums) == 0 {
+					m.Nums = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nums = append(m.Nums, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nums", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			m.Ack = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ack |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeliverInboundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeliverInboundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletSpendAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletSpendAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendAction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendAction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletSpendActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletSpendActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitationSpec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvitationSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferArgs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletActionBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletActionBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletActionBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, WalletBatchAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WalletBatchAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WalletBatchAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WalletBatchAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Spend = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletActionBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletActionBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletActionBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProvision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProvision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nickname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProvisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProvisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEgressPowerFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEgressPowerFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEgressPowerFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddPowerFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddPowerFlags = append(m.AddPowerFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovePowerFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovePowerFlags = append(m.RemovePowerFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEgressPowerFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEgressPowerFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEgressPowerFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeprovision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeprovision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeprovision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeprovisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeprovisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeprovisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstallBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstallBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bundle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedBundle", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressedBundle = append(m.CompressedBundle[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompressedBundle == nil {
+				m.CompressedBundle = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
+			}
+			m.UncompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstallBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstallBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstallBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCoreEval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCoreEval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCoreEval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evals = append(m.Evals, CoreEval{})
+			if err := m.Evals[len(m.Evals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bundles = append(m.Bundles, CoreEvalBundle{})
+			if err := m.Bundles[len(m.Bundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoreEvalBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreEvalBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreEvalBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedBundle", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressedBundle = append(m.CompressedBundle[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompressedBundle == nil {
+				m.CompressedBundle = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
+			}
+			m.UncompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCoreEvalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCoreEvalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCoreEvalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginBundleUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginBundleUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginBundleUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedSize", wireType)
+			}
+			m.CompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
+			}
+			m.UncompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgProvisionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgInstallBundle) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Bundle)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.CompressedBundle)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.UncompressedSize != 0 {
-		n += 1 + sovMsgs(uint64(m.UncompressedSize))
+func (m *MsgBeginBundleUploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginBundleUploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginBundleUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgInstallBundleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
+func (m *MsgUploadBundleChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1392,17 +6899,17 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeliverInbound: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUploadBundleChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeliverInbound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUploadBundleChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1412,105 +6919,63 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMsgs
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Nums = append(m.Nums, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMsgs
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMsgs
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthMsgs
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Nums) == 0 {
-					m.Nums = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMsgs
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Nums = append(m.Nums, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nums", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
 			}
-			m.Ack = 0
+			m.ChunkIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1520,14 +6985,14 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ack |= uint64(b&0x7F) << shift
+				m.ChunkIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1554,9 +7019,9 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1580,7 +7045,7 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUploadBundleChunkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1603,10 +7068,10 @@ func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeliverInboundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUploadBundleChunkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeliverInboundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUploadBundleChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1630,7 +7095,7 @@ func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
+func (m *MsgFinishBundleUpload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,15 +7118,15 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinishBundleUpload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinishBundleUpload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1688,14 +7153,14 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1723,7 +7188,7 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1746,7 +7211,7 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFinishBundleUploadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1769,10 +7234,10 @@ func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinishBundleUploadResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinishBundleUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1796,7 +7261,7 @@ func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInboundPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1819,17 +7284,17 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletSpendAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInboundPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletSpendAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInboundPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1839,31 +7304,29 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendAction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1873,23 +7336,24 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendAction = string(dAtA[iNdEx:postIndex])
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1912,7 +7376,7 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInboundPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1935,10 +7399,10 @@ func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletSpendActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInboundPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletSpendActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInboundPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1962,7 +7426,7 @@ func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProvision) Unmarshal(dAtA []byte) error {
+func (m *MsgPurgeInboundQueue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1985,15 +7449,15 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProvision: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurgeInboundQueue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurgeInboundQueue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2021,45 +7485,11 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nickname = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2087,13 +7517,13 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Criteria", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2103,24 +7533,23 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
+			if err := m.Criteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2144,7 +7573,7 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProvisionResponse) Unmarshal(dAtA []byte) error {
+func (m *InboundQueuePurgeCriteria) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2167,65 +7596,53 @@ func (m *MsgProvisionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProvisionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InboundQueuePurgeCriteria: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InboundQueuePurgeCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartIndex", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			m.StartIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndIndex", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EndIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstallBundle: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstallBundle: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2253,13 +7670,13 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bundle = string(dAtA[iNdEx:postIndex])
+			m.ActionType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2269,31 +7686,29 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressedBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2303,45 +7718,24 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CompressedBundle = append(m.CompressedBundle[:0], dAtA[iNdEx:postIndex]...)
-			if m.CompressedBundle == nil {
-				m.CompressedBundle = []byte{}
-			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
-			}
-			m.UncompressedSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UncompressedSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -2363,7 +7757,7 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstallBundleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPurgeInboundQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2386,12 +7780,31 @@ func (m *MsgInstallBundleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstallBundleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurgeInboundQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstallBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurgeInboundQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purged", wireType)
+			}
+			m.Purged = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Purged |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
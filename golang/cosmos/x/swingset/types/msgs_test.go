@@ -3,8 +3,10 @@ package types
 import (
 	"bytes"
 	"math"
+	"strings"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
@@ -54,6 +56,180 @@ func TestWalletAction(t *testing.T) {
 	}
 }
 
+func nullBatchActions(n int) []WalletBatchAction {
+	actions := make([]WalletBatchAction, n)
+	for i := range actions {
+		actions[i].Action = "null"
+	}
+	return actions
+}
+
+func TestWalletActionBatch(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgWalletActionBatch
+		shouldErr bool
+	}{
+		{
+			name:      "empty",
+			msg:       &MsgWalletActionBatch{},
+			shouldErr: true,
+		},
+		{
+			name: "normal",
+			msg: NewMsgWalletActionBatch(addr, []WalletBatchAction{
+				{Action: "null"},
+				{Action: "{}", Spend: true},
+			}),
+		},
+		{
+			name:      "no actions",
+			msg:       NewMsgWalletActionBatch(addr, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "empty action",
+			msg:       NewMsgWalletActionBatch(addr, []WalletBatchAction{{Action: "null"}, {Action: ""}}),
+			shouldErr: true,
+		},
+		{
+			name:      "bad json",
+			msg:       NewMsgWalletActionBatch(addr, []WalletBatchAction{{Action: "foo", Spend: true}}),
+			shouldErr: true,
+		},
+		{
+			name: "max actions",
+			msg:  NewMsgWalletActionBatch(addr, nullBatchActions(WalletActionBatchSizeLimit)),
+		},
+		{
+			name:      "too many actions",
+			msg:       NewMsgWalletActionBatch(addr, nullBatchActions(WalletActionBatchSizeLimit+1)),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestWalletActionBatchMsgCounts(t *testing.T) {
+	msg := NewMsgWalletActionBatch(addr, []WalletBatchAction{{Action: "null"}, {Action: "null"}})
+	if got := msg.GetInboundMsgCount(); got != 1 {
+		t.Errorf("got %d inbound messages, want 1", got)
+	}
+	if got := msg.GetAdmissionMsgCount(); got != 2 {
+		t.Errorf("got %d admission messages, want 2", got)
+	}
+	beansPerUnit := map[string]sdkmath.Uint{BeansPerMessage: sdkmath.NewUint(10)}
+	if got := EstimateAdmissionBeans(beansPerUnit, msg); !got.Equal(sdkmath.NewUint(20)) {
+		t.Errorf("got %s estimated beans, want 20", got)
+	}
+}
+
+func TestEstimateAdmissionBeansStorage(t *testing.T) {
+	beansPerUnit := map[string]sdkmath.Uint{BeansPerStorageByte: sdkmath.NewUint(3)}
+	upload := NewMsgBeginBundleUpload(addr, "b1-hash", 10, 100)
+	if got := EstimateAdmissionBeans(beansPerUnit, upload); !got.Equal(sdkmath.NewUint(300)) {
+		t.Errorf("got %s estimated beans for bundle upload, want 300", got)
+	}
+	install := NewMsgInstallBundle("{}", addr)
+	want := sdkmath.NewUint(3).MulUint64(install.ExpectedUncompressedSize())
+	if got := EstimateAdmissionBeans(beansPerUnit, install); !got.Equal(want) {
+		t.Errorf("got %s estimated beans for bundle install, want %s", got, want)
+	}
+}
+
+func TestUpdateEgressPowerFlags(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgUpdateEgressPowerFlags
+		shouldErr bool
+	}{
+		{
+			name:      "empty",
+			msg:       &MsgUpdateEgressPowerFlags{},
+			shouldErr: true,
+		},
+		{
+			name: "add",
+			msg:  NewMsgUpdateEgressPowerFlags(addr, []string{PowerFlagSmartWallet}, nil, addr),
+		},
+		{
+			name: "remove",
+			msg:  NewMsgUpdateEgressPowerFlags(addr, nil, []string{PowerFlagSmartWallet}, addr),
+		},
+		{
+			name:      "no change",
+			msg:       NewMsgUpdateEgressPowerFlags(addr, nil, nil, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "empty flag",
+			msg:       NewMsgUpdateEgressPowerFlags(addr, []string{""}, nil, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "add and remove",
+			msg:       NewMsgUpdateEgressPowerFlags(addr, []string{PowerFlagSmartWallet}, []string{PowerFlagSmartWallet}, addr),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestDeprovision(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgDeprovision
+		shouldErr bool
+	}{
+		{
+			name:      "empty",
+			msg:       &MsgDeprovision{},
+			shouldErr: true,
+		},
+		{
+			name: "normal",
+			msg:  NewMsgDeprovision(addr, "compromised", addr),
+		},
+		{
+			name: "reason at limit",
+			msg:  NewMsgDeprovision(addr, strings.Repeat("x", deprovisionReasonLengthLimit), addr),
+		},
+		{
+			name:      "reason too long",
+			msg:       NewMsgDeprovision(addr, strings.Repeat("x", deprovisionReasonLengthLimit+1), addr),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
 func TestWalletSpendAction(t *testing.T) {
 	for _, tt := range []struct {
 		name      string
@@ -262,3 +438,285 @@ func TestInstallBundle_Compress(t *testing.T) {
 		t.Errorf("wanted Uncompress error for high uncompressed size")
 	}
 }
+
+func TestCoreEval_ValidateBasic(t *testing.T) {
+	eval := CoreEval{JsonPermits: "true", JsCode: "42"}
+	bundle := CoreEvalBundle{CompressedBundle: []byte{1, 2, 3}, UncompressedSize: 4}
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgCoreEval
+		shouldErr bool
+	}{
+		{
+			name:      "empty",
+			msg:       &MsgCoreEval{},
+			shouldErr: true,
+		},
+		{
+			name: "normal",
+			msg:  NewMsgCoreEval(addr.String(), []CoreEval{eval}, nil),
+		},
+		{
+			name: "with bundles",
+			msg:  NewMsgCoreEval(addr.String(), []CoreEval{eval}, []CoreEvalBundle{bundle, bundle}),
+		},
+		{
+			name:      "bad authority",
+			msg:       NewMsgCoreEval("foo", []CoreEval{eval}, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "no evals",
+			msg:       NewMsgCoreEval(addr.String(), nil, []CoreEvalBundle{bundle}),
+			shouldErr: true,
+		},
+		{
+			name:      "empty bundle",
+			msg:       NewMsgCoreEval(addr.String(), []CoreEval{eval}, []CoreEvalBundle{{}}),
+			shouldErr: true,
+		},
+		{
+			name: "bundle size limit",
+			msg: NewMsgCoreEval(addr.String(), []CoreEval{eval}, []CoreEvalBundle{{
+				CompressedBundle: []byte{1, 2, 3},
+				UncompressedSize: bundleUncompressedSizeLimit,
+			}}),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestBundleUpload_ValidateBasic(t *testing.T) {
+	hash := BundleHashOf("true")
+	for _, tt := range []struct {
+		name      string
+		msg       sdk.Msg
+		shouldErr bool
+	}{
+		{
+			name:      "begin empty",
+			msg:       &MsgBeginBundleUpload{},
+			shouldErr: true,
+		},
+		{
+			name: "begin",
+			msg:  NewMsgBeginBundleUpload(addr, hash, 3, 4),
+		},
+		{
+			name:      "begin bad hash",
+			msg:       NewMsgBeginBundleUpload(addr, "abc", 3, 4),
+			shouldErr: true,
+		},
+		{
+			name:      "begin uppercase hash",
+			msg:       NewMsgBeginBundleUpload(addr, strings.ToUpper(hash), 3, 4),
+			shouldErr: true,
+		},
+		{
+			name:      "begin zero size",
+			msg:       NewMsgBeginBundleUpload(addr, hash, 0, 4),
+			shouldErr: true,
+		},
+		{
+			name: "begin over single message limit",
+			msg:  NewMsgBeginBundleUpload(addr, hash, bundleUncompressedSizeLimit, bundleUncompressedSizeLimit+1),
+		},
+		{
+			name:      "begin upload limit",
+			msg:       NewMsgBeginBundleUpload(addr, hash, 3, BundleUploadSizeLimit),
+			shouldErr: true,
+		},
+		{
+			name: "chunk",
+			msg:  NewMsgUploadBundleChunk(addr, hash, 0, []byte{1, 2, 3}),
+		},
+		{
+			name:      "empty chunk",
+			msg:       NewMsgUploadBundleChunk(addr, hash, 0, nil),
+			shouldErr: true,
+		},
+		{
+			name: "finish",
+			msg:  NewMsgFinishBundleUpload(addr, hash),
+		},
+		{
+			name:      "finish no submitter",
+			msg:       NewMsgFinishBundleUpload(nil, hash),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestInstallBundle_BundleID(t *testing.T) {
+	endoZip := `{"moduleFormat":"endoZipBase64","endoZipBase64Sha512":"abc123","endoZipBase64":""}`
+	for _, tt := range []struct {
+		name      string
+		bundle    string
+		compress  bool
+		want      string
+		shouldErr bool
+	}{
+		{
+			name:   "endoZipBase64",
+			bundle: endoZip,
+			want:   "b1-abc123",
+		},
+		{
+			name:     "compressed",
+			bundle:   endoZip,
+			compress: true,
+			want:     "b1-abc123",
+		},
+		{
+			name:   "other format",
+			bundle: `{"moduleFormat":"nestedEvaluate"}`,
+			want:   "b0-" + BundleHashOf(`{"moduleFormat":"nestedEvaluate"}`),
+		},
+		{
+			name:      "not json",
+			bundle:    "foo",
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := NewMsgInstallBundle(tt.bundle, addr)
+			if tt.compress {
+				if err := msg.Compress(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			got, err := msg.BundleID()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted error")
+			}
+			if got != tt.want {
+				t.Errorf("got bundle ID %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetInboundPause_ValidateBasic(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgSetInboundPause
+		shouldErr bool
+	}{
+		{
+			name: "resume",
+			msg:  NewMsgSetInboundPause(addr.String(), InboundPause{}),
+		},
+		{
+			name: "pause types",
+			msg:  NewMsgSetInboundPause(addr.String(), InboundPause{MsgTypeURLs: []string{"/agoric.swingset.MsgWalletAction"}, UntilHeight: 100}),
+		},
+		{
+			name: "pause all",
+			msg:  NewMsgSetInboundPause(addr.String(), InboundPause{All: true, AllowHighPriority: true}),
+		},
+		{
+			name:      "bad authority",
+			msg:       NewMsgSetInboundPause("foo", InboundPause{All: true}),
+			shouldErr: true,
+		},
+		{
+			name:      "bad type url",
+			msg:       NewMsgSetInboundPause(addr.String(), InboundPause{MsgTypeURLs: []string{"MsgWalletAction"}}),
+			shouldErr: true,
+		},
+		{
+			name:      "negative height",
+			msg:       NewMsgSetInboundPause(addr.String(), InboundPause{All: true, UntilHeight: -1}),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestPurgeInboundQueue_ValidateBasic(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgPurgeInboundQueue
+		shouldErr bool
+	}{
+		{
+			name: "by index",
+			msg:  NewMsgPurgeInboundQueue(addr.String(), "", InboundQueuePurgeCriteria{StartIndex: 3, EndIndex: 4}),
+		},
+		{
+			name: "by sender",
+			msg:  NewMsgPurgeInboundQueue(addr.String(), InboundQueueAction, InboundQueuePurgeCriteria{Sender: addr.String()}),
+		},
+		{
+			name: "high priority by type",
+			msg:  NewMsgPurgeInboundQueue(addr.String(), InboundQueueHighPriority, InboundQueuePurgeCriteria{ActionType: "CORE_EVAL"}),
+		},
+		{
+			name:      "bad authority",
+			msg:       NewMsgPurgeInboundQueue("foo", "", InboundQueuePurgeCriteria{TxHash: "abc"}),
+			shouldErr: true,
+		},
+		{
+			name:      "no criteria",
+			msg:       NewMsgPurgeInboundQueue(addr.String(), "", InboundQueuePurgeCriteria{}),
+			shouldErr: true,
+		},
+		{
+			name:      "unknown queue",
+			msg:       NewMsgPurgeInboundQueue(addr.String(), "mailbox", InboundQueuePurgeCriteria{TxHash: "abc"}),
+			shouldErr: true,
+		},
+		{
+			name:      "empty range",
+			msg:       NewMsgPurgeInboundQueue(addr.String(), "", InboundQueuePurgeCriteria{StartIndex: 4, EndIndex: 4}),
+			shouldErr: true,
+		},
+		{
+			name:      "high priority by sender",
+			msg:       NewMsgPurgeInboundQueue(addr.String(), InboundQueueHighPriority, InboundQueuePurgeCriteria{Sender: addr.String()}),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
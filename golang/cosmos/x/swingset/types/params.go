@@ -108,6 +108,37 @@ func validateBeansPerUnit(i interface{}) error {
 	return nil
 }
 
+// DefaultFeeDenom returns the denom in which beans are settled when the payer
+// does not choose an accepted one.  Since the fee unit price is a sorted
+// sdk.Coins, this is the alphabetically first of its denoms, or "" if there
+// are none.
+func (p Params) DefaultFeeDenom() string {
+	if len(p.FeeUnitPrice) == 0 {
+		return ""
+	}
+	return p.FeeUnitPrice[0].Denom
+}
+
+// FeeUnitPriceIn returns the fee unit price in the given denom, or in the
+// DefaultFeeDenom if the given one is empty, and whether the denom is accepted
+// for settling beans.
+func (p Params) FeeUnitPriceIn(denom string) (sdk.Coin, bool) {
+	if denom == "" {
+		denom = p.DefaultFeeDenom()
+	}
+	for _, price := range p.FeeUnitPrice {
+		if price.Denom != denom {
+			continue
+		}
+		if !price.Amount.IsPositive() {
+			// A zero price would make beans free.
+			return sdk.Coin{}, false
+		}
+		return price, true
+	}
+	return sdk.Coin{}, false
+}
+
 func validateFeeUnitPrice(i interface{}) error {
 	v, ok := i.(sdk.Coins)
 	if !ok {
@@ -127,53 +127,31 @@ func (m *CoreEval) GetJsCode() string {
 	return ""
 }
 
-// Params are the swingset configuration/governance parameters.
-type Params struct {
-	// Map from unit name to a value in SwingSet "beans".
-	// Must not be negative.
-	//
-	// These values are used by SwingSet to normalize named per-resource charges
-	// (maybe rent) in a single Nat usage unit, the "bean".
-	//
-	// There is no required order to this list of entries, but all the chain
-	// nodes must all serialize and deserialize the existing order without
-	// permuting it.
-	BeansPerUnit []StringBeans `protobuf:"bytes,1,rep,name=beans_per_unit,json=beansPerUnit,proto3" json:"beans_per_unit"`
-	// The price in Coins per the unit named "fee".  This value is used by
-	// cosmic-swingset JS code to decide how many tokens to charge.
-	//
-	// cost = beans_used * fee_unit_price / beans_per_unit["fee"]
-	FeeUnitPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_unit_price,json=feeUnitPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_unit_price"`
-	// The SwingSet bootstrap vat configuration file.  Not usefully modifiable
-	// via governance as it is only referenced by the chain's initial
-	// construction.
-	BootstrapVatConfig string `protobuf:"bytes,3,opt,name=bootstrap_vat_config,json=bootstrapVatConfig,proto3" json:"bootstrap_vat_config,omitempty"`
-	// If the provision submitter doesn't hold a provisionpass, their requested
-	// power flags are looked up in this fee menu (first match wins) and the sum
-	// is charged.  If any power flag is not found in this menu, the request is
-	// rejected.
-	PowerFlagFees []PowerFlagFee `protobuf:"bytes,4,rep,name=power_flag_fees,json=powerFlagFees,proto3" json:"power_flag_fees"`
-	// Maximum sizes for queues.
-	// These values are used by SwingSet to compute how many messages should be
-	// accepted in a block.
-	//
-	// There is no required order to this list of entries, but all the chain
-	// nodes must all serialize and deserialize the existing order without
-	// permuting it.
-	QueueMax []QueueSize `protobuf:"bytes,5,rep,name=queue_max,json=queueMax,proto3" json:"queue_max"`
+// CoreEvalOutcome records the result reported by the VM for the core evals of
+// a governance proposal.
+type CoreEvalOutcome struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposalId" yaml:"proposalId"`
+	// The number of MsgCoreEval of the proposal for which the VM has not yet
+	// reported a result.
+	Pending uint32 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Whether every core eval of the proposal has completed successfully.
+	Success bool `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// The first error reported by the VM, if any.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *Params) Reset()      { *m = Params{} }
-func (*Params) ProtoMessage() {}
-func (*Params) Descriptor() ([]byte, []int) {
+func (m *CoreEvalOutcome) Reset()         { *m = CoreEvalOutcome{} }
+func (m *CoreEvalOutcome) String() string { return proto.CompactTextString(m) }
+func (*CoreEvalOutcome) ProtoMessage()    {}
+func (*CoreEvalOutcome) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{2}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *CoreEvalOutcome) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CoreEvalOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CoreEvalOutcome.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -183,72 +161,71 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *CoreEvalOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoreEvalOutcome.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *CoreEvalOutcome) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *CoreEvalOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoreEvalOutcome.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
-
-func (m *Params) GetBeansPerUnit() []StringBeans {
-	if m != nil {
-		return m.BeansPerUnit
-	}
-	return nil
-}
+var xxx_messageInfo_CoreEvalOutcome proto.InternalMessageInfo
 
-func (m *Params) GetFeeUnitPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *CoreEvalOutcome) GetProposalId() uint64 {
 	if m != nil {
-		return m.FeeUnitPrice
+		return m.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (m *Params) GetBootstrapVatConfig() string {
+func (m *CoreEvalOutcome) GetPending() uint32 {
 	if m != nil {
-		return m.BootstrapVatConfig
+		return m.Pending
 	}
-	return ""
+	return 0
 }
 
-func (m *Params) GetPowerFlagFees() []PowerFlagFee {
+func (m *CoreEvalOutcome) GetSuccess() bool {
 	if m != nil {
-		return m.PowerFlagFees
+		return m.Success
 	}
-	return nil
+	return false
 }
 
-func (m *Params) GetQueueMax() []QueueSize {
+func (m *CoreEvalOutcome) GetError() string {
 	if m != nil {
-		return m.QueueMax
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-// The current state of the module.
-type State struct {
-	// The allowed number of items to add to queues, as determined by SwingSet.
-	// Transactions which attempt to enqueue more should be rejected.
-	QueueAllowed []QueueSize `protobuf:"bytes,1,rep,name=queue_allowed,json=queueAllowed,proto3" json:"queue_allowed"`
+// PendingBundleUpload is the state of a chunked bundle upload.
+type PendingBundleUpload struct {
+	Submitter        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	BundleHash       string                                        `protobuf:"bytes,2,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundleHash" yaml:"bundleHash"`
+	CompressedSize   int64                                         `protobuf:"varint,3,opt,name=compressed_size,json=compressedSize,proto3" json:"compressedSize" yaml:"compressedSize"`
+	UncompressedSize int64                                         `protobuf:"varint,4,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressedSize" yaml:"uncompressedSize"`
+	// The total size of the chunks uploaded so far.
+	ReceivedSize int64  `protobuf:"varint,5,opt,name=received_size,json=receivedSize,proto3" json:"receivedSize" yaml:"receivedSize"`
+	ChunkCount   uint32 `protobuf:"varint,6,opt,name=chunk_count,json=chunkCount,proto3" json:"chunkCount" yaml:"chunkCount"`
+	// The block height after which the upload is discarded.
+	ExpiryHeight int64 `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiryHeight" yaml:"expiryHeight"`
 }
 
-func (m *State) Reset()         { *m = State{} }
-func (m *State) String() string { return proto.CompactTextString(m) }
-func (*State) ProtoMessage()    {}
-func (*State) Descriptor() ([]byte, []int) {
+func (m *PendingBundleUpload) Reset()         { *m = PendingBundleUpload{} }
+func (m *PendingBundleUpload) String() string { return proto.CompactTextString(m) }
+func (*PendingBundleUpload) ProtoMessage()    {}
+func (*PendingBundleUpload) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{3}
 }
-func (m *State) XXX_Unmarshal(b []byte) error {
+func (m *PendingBundleUpload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingBundleUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_State.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingBundleUpload.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -258,45 +235,95 @@ func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *State) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_State.Merge(m, src)
+func (m *PendingBundleUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBundleUpload.Merge(m, src)
 }
-func (m *State) XXX_Size() int {
+func (m *PendingBundleUpload) XXX_Size() int {
 	return m.Size()
 }
-func (m *State) XXX_DiscardUnknown() {
-	xxx_messageInfo_State.DiscardUnknown(m)
+func (m *PendingBundleUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBundleUpload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_State proto.InternalMessageInfo
+var xxx_messageInfo_PendingBundleUpload proto.InternalMessageInfo
 
-func (m *State) GetQueueAllowed() []QueueSize {
+func (m *PendingBundleUpload) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.QueueAllowed
+		return m.Submitter
 	}
 	return nil
 }
 
-// Map element of a string key to a Nat bean count.
-type StringBeans struct {
-	// What the beans are for.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// The actual bean value.
-	Beans github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=beans,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"beans"`
+func (m *PendingBundleUpload) GetBundleHash() string {
+	if m != nil {
+		return m.BundleHash
+	}
+	return ""
 }
 
-func (m *StringBeans) Reset()         { *m = StringBeans{} }
-func (m *StringBeans) String() string { return proto.CompactTextString(m) }
-func (*StringBeans) ProtoMessage()    {}
-func (*StringBeans) Descriptor() ([]byte, []int) {
+func (m *PendingBundleUpload) GetCompressedSize() int64 {
+	if m != nil {
+		return m.CompressedSize
+	}
+	return 0
+}
+
+func (m *PendingBundleUpload) GetUncompressedSize() int64 {
+	if m != nil {
+		return m.UncompressedSize
+	}
+	return 0
+}
+
+func (m *PendingBundleUpload) GetReceivedSize() int64 {
+	if m != nil {
+		return m.ReceivedSize
+	}
+	return 0
+}
+
+func (m *PendingBundleUpload) GetChunkCount() uint32 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *PendingBundleUpload) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// InstalledBundle records the submission of a bundle for installation and
+// the outcome reported by the VM.
+type InstalledBundle struct {
+	// The bundle ID, e.g. "b1-" followed by the endoZipBase64Sha512 hash.
+	BundleId  string                                        `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundleId" yaml:"bundleId"`
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	// The block height at which the bundle was submitted.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"blockHeight" yaml:"blockHeight"`
+	// Whether the VM has yet to report the outcome of the installation.
+	Pending bool `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Whether the VM accepted the bundle.
+	Accepted bool `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// The error reported by the VM if it rejected the bundle.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *InstalledBundle) Reset()         { *m = InstalledBundle{} }
+func (m *InstalledBundle) String() string { return proto.CompactTextString(m) }
+func (*InstalledBundle) ProtoMessage()    {}
+func (*InstalledBundle) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{4}
 }
-func (m *StringBeans) XXX_Unmarshal(b []byte) error {
+func (m *InstalledBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StringBeans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstalledBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StringBeans.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstalledBundle.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -306,43 +333,78 @@ func (m *StringBeans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *StringBeans) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StringBeans.Merge(m, src)
+func (m *InstalledBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstalledBundle.Merge(m, src)
 }
-func (m *StringBeans) XXX_Size() int {
+func (m *InstalledBundle) XXX_Size() int {
 	return m.Size()
 }
-func (m *StringBeans) XXX_DiscardUnknown() {
-	xxx_messageInfo_StringBeans.DiscardUnknown(m)
+func (m *InstalledBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstalledBundle.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StringBeans proto.InternalMessageInfo
+var xxx_messageInfo_InstalledBundle proto.InternalMessageInfo
 
-func (m *StringBeans) GetKey() string {
+func (m *InstalledBundle) GetBundleId() string {
 	if m != nil {
-		return m.Key
+		return m.BundleId
 	}
 	return ""
 }
 
-// Map a provisioning power flag to its corresponding fee.
-type PowerFlagFee struct {
-	PowerFlag string                                   `protobuf:"bytes,1,opt,name=power_flag,json=powerFlag,proto3" json:"power_flag,omitempty"`
-	Fee       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+func (m *InstalledBundle) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
+	}
+	return nil
 }
 
-func (m *PowerFlagFee) Reset()         { *m = PowerFlagFee{} }
-func (m *PowerFlagFee) String() string { return proto.CompactTextString(m) }
-func (*PowerFlagFee) ProtoMessage()    {}
-func (*PowerFlagFee) Descriptor() ([]byte, []int) {
+func (m *InstalledBundle) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *InstalledBundle) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *InstalledBundle) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *InstalledBundle) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// ExtensionOptionFeeDenom is a Tx extension option choosing the denom of the
+// Params fee_unit_price in which the bean charges of the Tx are settled.
+type ExtensionOptionFeeDenom struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom" yaml:"denom"`
+}
+
+func (m *ExtensionOptionFeeDenom) Reset()         { *m = ExtensionOptionFeeDenom{} }
+func (m *ExtensionOptionFeeDenom) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionFeeDenom) ProtoMessage()    {}
+func (*ExtensionOptionFeeDenom) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{5}
 }
-func (m *PowerFlagFee) XXX_Unmarshal(b []byte) error {
+func (m *ExtensionOptionFeeDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PowerFlagFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExtensionOptionFeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PowerFlagFee.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExtensionOptionFeeDenom.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -352,52 +414,51 @@ func (m *PowerFlagFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PowerFlagFee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PowerFlagFee.Merge(m, src)
+func (m *ExtensionOptionFeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionFeeDenom.Merge(m, src)
 }
-func (m *PowerFlagFee) XXX_Size() int {
+func (m *ExtensionOptionFeeDenom) XXX_Size() int {
 	return m.Size()
 }
-func (m *PowerFlagFee) XXX_DiscardUnknown() {
-	xxx_messageInfo_PowerFlagFee.DiscardUnknown(m)
+func (m *ExtensionOptionFeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionFeeDenom.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PowerFlagFee proto.InternalMessageInfo
+var xxx_messageInfo_ExtensionOptionFeeDenom proto.InternalMessageInfo
 
-func (m *PowerFlagFee) GetPowerFlag() string {
+func (m *ExtensionOptionFeeDenom) GetDenom() string {
 	if m != nil {
-		return m.PowerFlag
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *PowerFlagFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.Fee
-	}
-	return nil
-}
-
-// Map element of a string key to a size.
-type QueueSize struct {
-	// What the size is for.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// The actual size value.
-	Size_ int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+// InboundPause describes a governance pause of inbound messages.
+type InboundPause struct {
+	// The type URLs of the paused messages, e.g.
+	// "/agoric.swingset.MsgWalletAction".
+	MsgTypeURLs []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msgTypeUrls" yaml:"msgTypeUrls"`
+	// Whether all inbound messages are paused, regardless of msg_type_urls.
+	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all" yaml:"all"`
+	// The block height at which the pause ends, or 0 to pause until further
+	// notice.
+	UntilHeight int64 `protobuf:"varint,3,opt,name=until_height,json=untilHeight,proto3" json:"untilHeight" yaml:"untilHeight"`
+	// Whether high-priority senders may still submit paused messages.
+	AllowHighPriority bool `protobuf:"varint,4,opt,name=allow_high_priority,json=allowHighPriority,proto3" json:"allowHighPriority" yaml:"allowHighPriority"`
 }
 
-func (m *QueueSize) Reset()         { *m = QueueSize{} }
-func (m *QueueSize) String() string { return proto.CompactTextString(m) }
-func (*QueueSize) ProtoMessage()    {}
-func (*QueueSize) Descriptor() ([]byte, []int) {
+func (m *InboundPause) Reset()         { *m = InboundPause{} }
+func (m *InboundPause) String() string { return proto.CompactTextString(m) }
+func (*InboundPause) ProtoMessage()    {}
+func (*InboundPause) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{6}
 }
-func (m *QueueSize) XXX_Unmarshal(b []byte) error {
+func (m *InboundPause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InboundPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueSize.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InboundPause.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -407,52 +468,75 @@ func (m *QueueSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *QueueSize) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueSize.Merge(m, src)
+func (m *InboundPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundPause.Merge(m, src)
 }
-func (m *QueueSize) XXX_Size() int {
+func (m *InboundPause) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueSize) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueSize.DiscardUnknown(m)
+func (m *InboundPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundPause.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueSize proto.InternalMessageInfo
+var xxx_messageInfo_InboundPause proto.InternalMessageInfo
 
-func (m *QueueSize) GetKey() string {
+func (m *InboundPause) GetMsgTypeURLs() []string {
 	if m != nil {
-		return m.Key
+		return m.MsgTypeURLs
 	}
-	return ""
+	return nil
 }
 
-func (m *QueueSize) GetSize_() int32 {
+func (m *InboundPause) GetAll() bool {
 	if m != nil {
-		return m.Size_
+		return m.All
+	}
+	return false
+}
+
+func (m *InboundPause) GetUntilHeight() int64 {
+	if m != nil {
+		return m.UntilHeight
 	}
 	return 0
 }
 
-// Egress is the format for a swingset egress.
-type Egress struct {
-	Nickname string                                        `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname" yaml:"nickname"`
-	Peer     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
-	// TODO: Remove these power flags as they are deprecated and have no effect.
-	PowerFlags []string `protobuf:"bytes,3,rep,name=power_flags,json=powerFlags,proto3" json:"powerFlags" yaml:"powerFlags"`
+func (m *InboundPause) GetAllowHighPriority() bool {
+	if m != nil {
+		return m.AllowHighPriority
+	}
+	return false
 }
 
-func (m *Egress) Reset()         { *m = Egress{} }
-func (m *Egress) String() string { return proto.CompactTextString(m) }
-func (*Egress) ProtoMessage()    {}
-func (*Egress) Descriptor() ([]byte, []int) {
+// BlockStats are the execution statistics of a block reported by the VM in
+// its reply to END_BLOCK.
+type BlockStats struct {
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"blockHeight" yaml:"blockHeight"`
+	// The computrons used by the cranks of the block.
+	Computrons github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=computrons,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"computrons" yaml:"computrons"`
+	// The beans charged by the run policy, of which the computrons are a part.
+	BeansUsed github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=beans_used,json=beansUsed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"beansUsed" yaml:"beansUsed"`
+	// The number of cranks run.
+	Cranks uint64 `protobuf:"varint,4,opt,name=cranks,proto3" json:"cranks" yaml:"cranks"`
+	// The number of inbound queue items processed.
+	InboundProcessed uint64 `protobuf:"varint,5,opt,name=inbound_processed,json=inboundProcessed,proto3" json:"inboundProcessed" yaml:"inboundProcessed"`
+	// Whether inbound queue items remained unprocessed when the block ended,
+	// left for the next blocks.
+	StoppedEarly bool `protobuf:"varint,6,opt,name=stopped_early,json=stoppedEarly,proto3" json:"stoppedEarly" yaml:"stoppedEarly"`
+}
+
+func (m *BlockStats) Reset()         { *m = BlockStats{} }
+func (m *BlockStats) String() string { return proto.CompactTextString(m) }
+func (*BlockStats) ProtoMessage()    {}
+func (*BlockStats) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{7}
 }
-func (m *Egress) XXX_Unmarshal(b []byte) error {
+func (m *BlockStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Egress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlockStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Egress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlockStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -462,60 +546,71 @@ func (m *Egress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Egress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Egress.Merge(m, src)
+func (m *BlockStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockStats.Merge(m, src)
 }
-func (m *Egress) XXX_Size() int {
+func (m *BlockStats) XXX_Size() int {
 	return m.Size()
 }
-func (m *Egress) XXX_DiscardUnknown() {
-	xxx_messageInfo_Egress.DiscardUnknown(m)
+func (m *BlockStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Egress proto.InternalMessageInfo
+var xxx_messageInfo_BlockStats proto.InternalMessageInfo
 
-func (m *Egress) GetNickname() string {
+func (m *BlockStats) GetBlockHeight() int64 {
 	if m != nil {
-		return m.Nickname
+		return m.BlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *Egress) GetPeer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *BlockStats) GetCranks() uint64 {
 	if m != nil {
-		return m.Peer
+		return m.Cranks
 	}
-	return nil
+	return 0
 }
 
-func (m *Egress) GetPowerFlags() []string {
+func (m *BlockStats) GetInboundProcessed() uint64 {
 	if m != nil {
-		return m.PowerFlags
+		return m.InboundProcessed
 	}
-	return nil
+	return 0
 }
 
-// SwingStoreArtifact encodes an artifact of a swing-store export.
-// Artifacts may be stored or transmitted in any order. Most handlers do
-// maintain the artifact order from their original source as an effect of how
-// they handle the artifacts.
-type SwingStoreArtifact struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data" yaml:"data"`
+func (m *BlockStats) GetStoppedEarly() bool {
+	if m != nil {
+		return m.StoppedEarly
+	}
+	return false
 }
 
-func (m *SwingStoreArtifact) Reset()         { *m = SwingStoreArtifact{} }
-func (m *SwingStoreArtifact) String() string { return proto.CompactTextString(m) }
-func (*SwingStoreArtifact) ProtoMessage()    {}
-func (*SwingStoreArtifact) Descriptor() ([]byte, []int) {
+// ExportDataCheck is the outcome of comparing the swing-store "export data"
+// replicated in the swingset store with the export data of the VM.
+type ExportDataCheck struct {
+	// The height of the last committed block, whose export data was compared.
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"blockHeight" yaml:"blockHeight"`
+	// The hash of the export data replicated in the swingset store.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash" yaml:"hash"`
+	// The hash of the export data reported by the VM.
+	VmHash string `protobuf:"bytes,3,opt,name=vm_hash,json=vmHash,proto3" json:"vmHash" yaml:"vmHash"`
+	// Whether both hashes are equal.
+	Consistent bool `protobuf:"varint,4,opt,name=consistent,proto3" json:"consistent" yaml:"consistent"`
+}
+
+func (m *ExportDataCheck) Reset()         { *m = ExportDataCheck{} }
+func (m *ExportDataCheck) String() string { return proto.CompactTextString(m) }
+func (*ExportDataCheck) ProtoMessage()    {}
+func (*ExportDataCheck) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{8}
 }
-func (m *SwingStoreArtifact) XXX_Unmarshal(b []byte) error {
+func (m *ExportDataCheck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SwingStoreArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExportDataCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SwingStoreArtifact.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExportDataCheck.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -525,576 +620,746 @@ func (m *SwingStoreArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SwingStoreArtifact) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SwingStoreArtifact.Merge(m, src)
+func (m *ExportDataCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDataCheck.Merge(m, src)
 }
-func (m *SwingStoreArtifact) XXX_Size() int {
+func (m *ExportDataCheck) XXX_Size() int {
 	return m.Size()
 }
-func (m *SwingStoreArtifact) XXX_DiscardUnknown() {
-	xxx_messageInfo_SwingStoreArtifact.DiscardUnknown(m)
+func (m *ExportDataCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDataCheck.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SwingStoreArtifact proto.InternalMessageInfo
+var xxx_messageInfo_ExportDataCheck proto.InternalMessageInfo
 
-func (m *SwingStoreArtifact) GetName() string {
+func (m *ExportDataCheck) GetBlockHeight() int64 {
 	if m != nil {
-		return m.Name
+		return m.BlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *SwingStoreArtifact) GetData() []byte {
+func (m *ExportDataCheck) GetHash() string {
 	if m != nil {
-		return m.Data
+		return m.Hash
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*CoreEvalProposal)(nil), "agoric.swingset.CoreEvalProposal")
-	proto.RegisterType((*CoreEval)(nil), "agoric.swingset.CoreEval")
-	proto.RegisterType((*Params)(nil), "agoric.swingset.Params")
-	proto.RegisterType((*State)(nil), "agoric.swingset.State")
-	proto.RegisterType((*StringBeans)(nil), "agoric.swingset.StringBeans")
-	proto.RegisterType((*PowerFlagFee)(nil), "agoric.swingset.PowerFlagFee")
-	proto.RegisterType((*QueueSize)(nil), "agoric.swingset.QueueSize")
-	proto.RegisterType((*Egress)(nil), "agoric.swingset.Egress")
-	proto.RegisterType((*SwingStoreArtifact)(nil), "agoric.swingset.SwingStoreArtifact")
+func (m *ExportDataCheck) GetVmHash() string {
+	if m != nil {
+		return m.VmHash
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("agoric/swingset/swingset.proto", fileDescriptor_ff9c341e0de15f8b) }
-
-var fileDescriptor_ff9c341e0de15f8b = []byte{
-	// 842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x49, 0x52, 0x9a, 0x97, 0x6c, 0xbb, 0x0c, 0x95, 0x36, 0x54, 0x6c, 0xa6, 0xf2, 0x85,
-	0x4a, 0xab, 0x8d, 0xb7, 0x20, 0x84, 0x94, 0x15, 0x87, 0xb8, 0xea, 0x6a, 0x25, 0x04, 0x0a, 0x8e,
-	0xca, 0x01, 0x81, 0xac, 0x89, 0x33, 0x31, 0xd3, 0x3a, 0x1e, 0xef, 0xcc, 0xf4, 0xd7, 0xfe, 0x03,
-	0x70, 0x41, 0x42, 0x9c, 0x38, 0xf6, 0xcc, 0x5f, 0xb2, 0xc7, 0x3d, 0x22, 0x0e, 0x06, 0xb5, 0x17,
-	0xd4, 0x63, 0x8e, 0x48, 0x48, 0x68, 0x66, 0x1c, 0xc7, 0xa2, 0x48, 0xf4, 0xc2, 0x29, 0xf3, 0x7e,
-	0x7d, 0xef, 0x7d, 0xdf, 0x1b, 0x4f, 0xa0, 0x47, 0x62, 0x2e, 0x58, 0xe4, 0xc9, 0x33, 0x96, 0xc6,
-	0x92, 0xaa, 0xf2, 0xd0, 0xcf, 0x04, 0x57, 0x1c, 0x6d, 0xda, 0x78, 0x7f, 0xe9, 0xde, 0xde, 0x8a,
-	0x79, 0xcc, 0x4d, 0xcc, 0xd3, 0x27, 0x9b, 0xb6, 0xdd, 0x8b, 0xb8, 0x9c, 0x73, 0xe9, 0x4d, 0x88,
-	0xa4, 0xde, 0xe9, 0xde, 0x84, 0x2a, 0xb2, 0xe7, 0x45, 0x9c, 0xa5, 0x36, 0xee, 0x7e, 0xeb, 0xc0,
-	0xfd, 0x7d, 0x2e, 0xe8, 0xc1, 0x29, 0x49, 0x46, 0x82, 0x67, 0x5c, 0x92, 0x04, 0x6d, 0x41, 0x53,
-	0x31, 0x95, 0xd0, 0xae, 0xb3, 0xe3, 0xec, 0xb6, 0x02, 0x6b, 0xa0, 0x1d, 0x68, 0x4f, 0xa9, 0x8c,
-	0x04, 0xcb, 0x14, 0xe3, 0x69, 0xf7, 0x0d, 0x13, 0xab, 0xba, 0xd0, 0x87, 0xd0, 0xa4, 0xa7, 0x24,
-	0x91, 0xdd, 0xfa, 0x4e, 0x7d, 0xb7, 0xfd, 0xfe, 0x3b, 0xfd, 0x7f, 0xcc, 0xd8, 0x5f, 0x76, 0xf2,
-	0x1b, 0xaf, 0x72, 0x5c, 0x0b, 0x6c, 0xf6, 0xa0, 0xf1, 0xdd, 0x25, 0xae, 0xb9, 0x12, 0xd6, 0x97,
-	0x61, 0x34, 0x80, 0xce, 0x91, 0xe4, 0x69, 0x98, 0x51, 0x31, 0x67, 0x4a, 0xda, 0x39, 0xfc, 0x07,
-	0x8b, 0x1c, 0xbf, 0x7d, 0x41, 0xe6, 0xc9, 0xc0, 0xad, 0x46, 0xdd, 0xa0, 0xad, 0xcd, 0x91, 0xb5,
-	0xd0, 0x23, 0x78, 0xf3, 0x48, 0x86, 0x11, 0x9f, 0x52, 0x3b, 0xa2, 0x8f, 0x16, 0x39, 0xde, 0x58,
-	0x96, 0x99, 0x80, 0x1b, 0xac, 0x1d, 0xc9, 0x7d, 0x7d, 0xf8, 0xbe, 0x0e, 0x6b, 0x23, 0x22, 0xc8,
-	0x5c, 0xa2, 0xe7, 0xb0, 0x31, 0xa1, 0x24, 0x95, 0x1a, 0x36, 0x3c, 0x49, 0x99, 0xea, 0x3a, 0x86,
-	0xc5, 0xbb, 0xb7, 0x58, 0x8c, 0x95, 0x60, 0x69, 0xec, 0xeb, 0xe4, 0x82, 0x48, 0xc7, 0x54, 0x8e,
-	0xa8, 0x38, 0x4c, 0x99, 0x42, 0x2f, 0x60, 0x63, 0x46, 0xa9, 0xc1, 0x08, 0x33, 0xc1, 0x22, 0x3d,
-	0x88, 0xd5, 0xc3, 0x2e, 0xa3, 0xaf, 0x97, 0xd1, 0x2f, 0x96, 0xd1, 0xdf, 0xe7, 0x2c, 0xf5, 0x9f,
-	0x68, 0x98, 0x9f, 0x7f, 0xc3, 0xbb, 0x31, 0x53, 0xdf, 0x9c, 0x4c, 0xfa, 0x11, 0x9f, 0x7b, 0xc5,
-	0xe6, 0xec, 0xcf, 0x63, 0x39, 0x3d, 0xf6, 0xd4, 0x45, 0x46, 0xa5, 0x29, 0x90, 0x41, 0x67, 0x46,
-	0xa9, 0xee, 0x36, 0xd2, 0x0d, 0xd0, 0x13, 0xd8, 0x9a, 0x70, 0xae, 0xa4, 0x12, 0x24, 0x0b, 0x4f,
-	0x89, 0x0a, 0x23, 0x9e, 0xce, 0x58, 0xdc, 0xad, 0x9b, 0x25, 0xa1, 0x32, 0xf6, 0x05, 0x51, 0xfb,
-	0x26, 0x82, 0x3e, 0x81, 0xcd, 0x8c, 0x9f, 0x51, 0x11, 0xce, 0x12, 0x12, 0x87, 0x33, 0x4a, 0x65,
-	0xb7, 0x61, 0xa6, 0x7c, 0x78, 0x8b, 0xef, 0x48, 0xe7, 0x3d, 0x4b, 0x48, 0xfc, 0x8c, 0xd2, 0x82,
-	0xf0, 0xbd, 0xac, 0xe2, 0x93, 0xe8, 0x63, 0x68, 0xbd, 0x38, 0xa1, 0x27, 0x34, 0x9c, 0x93, 0xf3,
-	0x6e, 0xd3, 0xc0, 0x6c, 0xdf, 0x82, 0xf9, 0x5c, 0x67, 0x8c, 0xd9, 0xcb, 0x25, 0xc6, 0xba, 0x29,
-	0xf9, 0x94, 0x9c, 0x0f, 0xd6, 0x7f, 0xba, 0xc4, 0xb5, 0x3f, 0x2e, 0xb1, 0xe3, 0x7e, 0x06, 0xcd,
-	0xb1, 0x22, 0x8a, 0xa2, 0x03, 0xb8, 0x67, 0x11, 0x49, 0x92, 0xf0, 0x33, 0x3a, 0x2d, 0x96, 0xf1,
-	0xdf, 0xa8, 0x1d, 0x53, 0x36, 0xb4, 0x55, 0x6e, 0x02, 0xed, 0xca, 0xb6, 0xd0, 0x7d, 0xa8, 0x1f,
-	0xd3, 0x8b, 0xe2, 0x5a, 0xeb, 0x23, 0x3a, 0x80, 0xa6, 0xd9, 0x5d, 0x71, 0x57, 0x3c, 0x8d, 0xf1,
-	0x6b, 0x8e, 0xdf, 0xbb, 0xc3, 0x1e, 0x0e, 0x59, 0xaa, 0x02, 0x5b, 0x3d, 0x68, 0x98, 0xe9, 0x7f,
-	0x74, 0xa0, 0x53, 0x15, 0x0b, 0x3d, 0x04, 0x58, 0x89, 0x5c, 0xb4, 0x6d, 0x95, 0xd2, 0xa1, 0xaf,
-	0xa1, 0x3e, 0xa3, 0xff, 0xcb, 0xed, 0xd0, 0xb8, 0xc5, 0x50, 0x1f, 0x41, 0xab, 0xd4, 0xe8, 0x5f,
-	0x04, 0x40, 0xd0, 0x90, 0xec, 0xa5, 0xfd, 0x56, 0x9a, 0x81, 0x39, 0x17, 0x85, 0x7f, 0x39, 0xb0,
-	0x76, 0x10, 0x0b, 0x2a, 0x25, 0x7a, 0x0a, 0xeb, 0x29, 0x8b, 0x8e, 0x53, 0x32, 0x2f, 0xde, 0x04,
-	0x1f, 0xdf, 0xe4, 0xb8, 0xf4, 0x2d, 0x72, 0xbc, 0x69, 0x3f, 0xb0, 0xa5, 0xc7, 0x0d, 0xca, 0x20,
-	0xfa, 0x0a, 0x1a, 0x19, 0xa5, 0xc2, 0x74, 0xe8, 0xf8, 0xcf, 0x6f, 0x72, 0x6c, 0xec, 0x45, 0x8e,
-	0xdb, 0xb6, 0x48, 0x5b, 0xee, 0x9f, 0x39, 0x7e, 0x7c, 0x07, 0x7a, 0xc3, 0x28, 0x1a, 0x4e, 0xa7,
-	0x7a, 0xa8, 0xc0, 0xa0, 0xa0, 0x00, 0xda, 0x2b, 0x89, 0xed, 0xcb, 0xd3, 0xf2, 0xf7, 0xae, 0x72,
-	0x0c, 0xe5, 0x26, 0xe4, 0x4d, 0x8e, 0xa1, 0x54, 0x5d, 0x2e, 0x72, 0xfc, 0x56, 0xd1, 0xb8, 0xf4,
-	0xb9, 0x41, 0x25, 0xc1, 0xf0, 0xaf, 0xb9, 0x0a, 0xd0, 0x58, 0xdf, 0xb2, 0xb1, 0xe2, 0x82, 0x0e,
-	0x85, 0x62, 0x33, 0x12, 0x29, 0xf4, 0x08, 0x1a, 0x15, 0x19, 0x1e, 0x68, 0x36, 0x85, 0x04, 0x05,
-	0x1b, 0x4b, 0xdf, 0x38, 0x75, 0xf2, 0x94, 0x28, 0x52, 0x50, 0x37, 0xc9, 0xda, 0x5e, 0x25, 0x6b,
-	0xcb, 0x0d, 0x8c, 0xd3, 0x76, 0xf5, 0x0f, 0x5f, 0x5d, 0xf5, 0x9c, 0xd7, 0x57, 0x3d, 0xe7, 0xf7,
-	0xab, 0x9e, 0xf3, 0xc3, 0x75, 0xaf, 0xf6, 0xfa, 0xba, 0x57, 0xfb, 0xe5, 0xba, 0x57, 0xfb, 0xf2,
-	0x69, 0x45, 0x9e, 0xa1, 0xfd, 0x73, 0xb0, 0x1f, 0x83, 0x91, 0x27, 0xe6, 0x09, 0x49, 0xe3, 0xa5,
-	0x6e, 0xe7, 0xab, 0xff, 0x0d, 0xa3, 0xdb, 0x64, 0xcd, 0x3c, 0xf7, 0x1f, 0xfc, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0xee, 0x34, 0x5f, 0xf6, 0x57, 0x06, 0x00, 0x00,
+func (m *ExportDataCheck) GetConsistent() bool {
+	if m != nil {
+		return m.Consistent
+	}
+	return false
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+// Params are the swingset configuration/governance parameters.
+type Params struct {
+	// Map from unit name to a value in SwingSet "beans".
+	// Must not be negative.
+	//
+	// These values are used by SwingSet to normalize named per-resource charges
+	// (maybe rent) in a single Nat usage unit, the "bean".
+	//
+	// There is no required order to this list of entries, but all the chain
+	// nodes must all serialize and deserialize the existing order without
+	// permuting it.
+	BeansPerUnit []StringBeans `protobuf:"bytes,1,rep,name=beans_per_unit,json=beansPerUnit,proto3" json:"beans_per_unit"`
+	// The price in Coins per the unit named "fee".  This value is used by
+	// cosmic-swingset JS code to decide how many tokens to charge.  Each coin
+	// is an alternative: the payer settles beans in a single denom, chosen
+	// with an ExtensionOptionFeeDenom or by the Tx fee, defaulting to the
+	// first one, which is the alphabetically first denom since the list is
+	// sorted.  Without any price, beans are free but still recorded as owed.
+	//
+	// cost = beans_used * fee_unit_price / beans_per_unit["fee"]
+	FeeUnitPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_unit_price,json=feeUnitPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_unit_price"`
+	// The SwingSet bootstrap vat configuration file.  Not usefully modifiable
+	// via governance as it is only referenced by the chain's initial
+	// construction.
+	BootstrapVatConfig string `protobuf:"bytes,3,opt,name=bootstrap_vat_config,json=bootstrapVatConfig,proto3" json:"bootstrap_vat_config,omitempty"`
+	// If the provision submitter doesn't hold a provisionpass, their requested
+	// power flags are looked up in this fee menu (first match wins) and the sum
+	// is charged.  If any power flag is not found in this menu, the request is
+	// rejected.
+	PowerFlagFees []PowerFlagFee `protobuf:"bytes,4,rep,name=power_flag_fees,json=powerFlagFees,proto3" json:"power_flag_fees"`
+	// Maximum sizes for queues.
+	// These values are used by SwingSet to compute how many messages should be
+	// accepted in a block.
+	//
+	// There is no required order to this list of entries, but all the chain
+	// nodes must all serialize and deserialize the existing order without
+	// permuting it.
+	QueueMax []QueueSize `protobuf:"bytes,5,rep,name=queue_max,json=queueMax,proto3" json:"queue_max"`
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.BeansPerUnit) != len(that1.BeansPerUnit) {
-		return false
-	}
-	for i := range this.BeansPerUnit {
-		if !this.BeansPerUnit[i].Equal(&that1.BeansPerUnit[i]) {
-			return false
-		}
-	}
-	if len(this.FeeUnitPrice) != len(that1.FeeUnitPrice) {
-		return false
-	}
-	for i := range this.FeeUnitPrice {
-		if !this.FeeUnitPrice[i].Equal(&that1.FeeUnitPrice[i]) {
-			return false
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{9}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.BootstrapVatConfig != that1.BootstrapVatConfig {
-		return false
-	}
-	if len(this.PowerFlagFees) != len(that1.PowerFlagFees) {
-		return false
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBeansPerUnit() []StringBeans {
+	if m != nil {
+		return m.BeansPerUnit
 	}
-	for i := range this.PowerFlagFees {
-		if !this.PowerFlagFees[i].Equal(&that1.PowerFlagFees[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *Params) GetFeeUnitPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeUnitPrice
 	}
-	if len(this.QueueMax) != len(that1.QueueMax) {
-		return false
+	return nil
+}
+
+func (m *Params) GetBootstrapVatConfig() string {
+	if m != nil {
+		return m.BootstrapVatConfig
 	}
-	for i := range this.QueueMax {
-		if !this.QueueMax[i].Equal(&that1.QueueMax[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *Params) GetPowerFlagFees() []PowerFlagFee {
+	if m != nil {
+		return m.PowerFlagFees
 	}
-	return true
+	return nil
 }
-func (this *StringBeans) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Params) GetQueueMax() []QueueSize {
+	if m != nil {
+		return m.QueueMax
 	}
+	return nil
+}
 
-	that1, ok := that.(*StringBeans)
-	if !ok {
-		that2, ok := that.(StringBeans)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// The current state of the module.
+type State struct {
+	// The allowed number of items to add to queues, as determined by SwingSet.
+	// Transactions which attempt to enqueue more should be rejected.
+	QueueAllowed []QueueSize `protobuf:"bytes,1,rep,name=queue_allowed,json=queueAllowed,proto3" json:"queue_allowed"`
+}
+
+func (m *State) Reset()         { *m = State{} }
+func (m *State) String() string { return proto.CompactTextString(m) }
+func (*State) ProtoMessage()    {}
+func (*State) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{10}
+}
+func (m *State) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_State.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
-	}
-	if !this.Beans.Equal(that1.Beans) {
-		return false
-	}
-	return true
 }
-func (this *PowerFlagFee) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *State) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_State.Merge(m, src)
+}
+func (m *State) XXX_Size() int {
+	return m.Size()
+}
+func (m *State) XXX_DiscardUnknown() {
+	xxx_messageInfo_State.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*PowerFlagFee)
-	if !ok {
-		that2, ok := that.(PowerFlagFee)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_State proto.InternalMessageInfo
+
+func (m *State) GetQueueAllowed() []QueueSize {
+	if m != nil {
+		return m.QueueAllowed
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PowerFlag != that1.PowerFlag {
-		return false
-	}
-	if len(this.Fee) != len(that1.Fee) {
-		return false
-	}
-	for i := range this.Fee {
-		if !this.Fee[i].Equal(&that1.Fee[i]) {
-			return false
-		}
-	}
-	return true
+	return nil
 }
-func (this *QueueSize) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueueSize)
-	if !ok {
-		that2, ok := that.(QueueSize)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Map element of a string key to a Nat bean count.
+type StringBeans struct {
+	// What the beans are for.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The actual bean value.
+	Beans github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=beans,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"beans"`
+}
+
+func (m *StringBeans) Reset()         { *m = StringBeans{} }
+func (m *StringBeans) String() string { return proto.CompactTextString(m) }
+func (*StringBeans) ProtoMessage()    {}
+func (*StringBeans) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{11}
+}
+func (m *StringBeans) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StringBeans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StringBeans.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
-	}
-	if this.Size_ != that1.Size_ {
-		return false
-	}
-	return true
 }
-func (m *CoreEvalProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StringBeans) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StringBeans.Merge(m, src)
+}
+func (m *StringBeans) XXX_Size() int {
+	return m.Size()
+}
+func (m *StringBeans) XXX_DiscardUnknown() {
+	xxx_messageInfo_StringBeans.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StringBeans proto.InternalMessageInfo
+
+func (m *StringBeans) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CoreEvalProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Map a provisioning power flag to its corresponding fee.
+type PowerFlagFee struct {
+	PowerFlag string                                   `protobuf:"bytes,1,opt,name=power_flag,json=powerFlag,proto3" json:"power_flag,omitempty"`
+	Fee       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
-func (m *CoreEvalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Evals) > 0 {
-		for iNdEx := len(m.Evals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Evals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *PowerFlagFee) Reset()         { *m = PowerFlagFee{} }
+func (m *PowerFlagFee) String() string { return proto.CompactTextString(m) }
+func (*PowerFlagFee) ProtoMessage()    {}
+func (*PowerFlagFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{12}
+}
+func (m *PowerFlagFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PowerFlagFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PowerFlagFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PowerFlagFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerFlagFee.Merge(m, src)
+}
+func (m *PowerFlagFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PowerFlagFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerFlagFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PowerFlagFee proto.InternalMessageInfo
+
+func (m *PowerFlagFee) GetPowerFlag() string {
+	if m != nil {
+		return m.PowerFlag
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CoreEval) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PowerFlagFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CoreEval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Map element of a string key to a size.
+type QueueSize struct {
+	// What the size is for.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The actual size value.
+	Size_ int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (m *CoreEval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JsCode) > 0 {
-		i -= len(m.JsCode)
-		copy(dAtA[i:], m.JsCode)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsCode)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueueSize) Reset()         { *m = QueueSize{} }
+func (m *QueueSize) String() string { return proto.CompactTextString(m) }
+func (*QueueSize) ProtoMessage()    {}
+func (*QueueSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{13}
+}
+func (m *QueueSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.JsonPermits) > 0 {
-		i -= len(m.JsonPermits)
-		copy(dAtA[i:], m.JsonPermits)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsonPermits)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueueSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueSize.Merge(m, src)
+}
+func (m *QueueSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueSize proto.InternalMessageInfo
+
+func (m *QueueSize) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueSize) GetSize_() int32 {
+	if m != nil {
+		return m.Size_
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Egress is the format for a swingset egress.
+type Egress struct {
+	Nickname string                                        `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname" yaml:"nickname"`
+	Peer     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
+	// TODO: Remove these power flags as they are deprecated and have no effect.
+	PowerFlags []string `protobuf:"bytes,3,rep,name=power_flags,json=powerFlags,proto3" json:"powerFlags" yaml:"powerFlags"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueueMax) > 0 {
-		for iNdEx := len(m.QueueMax) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueueMax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.PowerFlagFees) > 0 {
-		for iNdEx := len(m.PowerFlagFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PowerFlagFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.BootstrapVatConfig) > 0 {
-		i -= len(m.BootstrapVatConfig)
-		copy(dAtA[i:], m.BootstrapVatConfig)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BootstrapVatConfig)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FeeUnitPrice) > 0 {
-		for iNdEx := len(m.FeeUnitPrice) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeUnitPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.BeansPerUnit) > 0 {
-		for iNdEx := len(m.BeansPerUnit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BeansPerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *Egress) Reset()         { *m = Egress{} }
+func (m *Egress) String() string { return proto.CompactTextString(m) }
+func (*Egress) ProtoMessage()    {}
+func (*Egress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{14}
+}
+func (m *Egress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Egress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Egress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *State) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Egress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Egress.Merge(m, src)
+}
+func (m *Egress) XXX_Size() int {
+	return m.Size()
+}
+func (m *Egress) XXX_DiscardUnknown() {
+	xxx_messageInfo_Egress.DiscardUnknown(m)
 }
 
-func (m *State) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Egress proto.InternalMessageInfo
+
+func (m *Egress) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
 }
 
-func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueueAllowed) > 0 {
-		for iNdEx := len(m.QueueAllowed) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueueAllowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *Egress) GetPeer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Peer
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *StringBeans) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Egress) GetPowerFlags() []string {
+	if m != nil {
+		return m.PowerFlags
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StringBeans) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SwingStoreArtifact encodes an artifact of a swing-store export.
+// Artifacts may be stored or transmitted in any order. Most handlers do
+// maintain the artifact order from their original source as an effect of how
+// they handle the artifacts.
+type SwingStoreArtifact struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data" yaml:"data"`
 }
 
-func (m *StringBeans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Beans.Size()
-		i -= size
-		if _, err := m.Beans.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *SwingStoreArtifact) Reset()         { *m = SwingStoreArtifact{} }
+func (m *SwingStoreArtifact) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreArtifact) ProtoMessage()    {}
+func (*SwingStoreArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{15}
+}
+func (m *SwingStoreArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreArtifact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintSwingset(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PowerFlagFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SwingStoreArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreArtifact.Merge(m, src)
 }
-
-func (m *PowerFlagFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SwingStoreArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreArtifact.DiscardUnknown(m)
 }
 
-func (m *PowerFlagFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fee) > 0 {
-		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.PowerFlag) > 0 {
-		i -= len(m.PowerFlag)
-		copy(dAtA[i:], m.PowerFlag)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlag)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_SwingStoreArtifact proto.InternalMessageInfo
+
+func (m *SwingStoreArtifact) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueueSize) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SwingStoreArtifact) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueueSize) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*CoreEvalProposal)(nil), "agoric.swingset.CoreEvalProposal")
+	proto.RegisterType((*CoreEval)(nil), "agoric.swingset.CoreEval")
+	proto.RegisterType((*CoreEvalOutcome)(nil), "agoric.swingset.CoreEvalOutcome")
+	proto.RegisterType((*PendingBundleUpload)(nil), "agoric.swingset.PendingBundleUpload")
+	proto.RegisterType((*InstalledBundle)(nil), "agoric.swingset.InstalledBundle")
+	proto.RegisterType((*ExtensionOptionFeeDenom)(nil), "agoric.swingset.ExtensionOptionFeeDenom")
+	proto.RegisterType((*InboundPause)(nil), "agoric.swingset.InboundPause")
+	proto.RegisterType((*BlockStats)(nil), "agoric.swingset.BlockStats")
+	proto.RegisterType((*ExportDataCheck)(nil), "agoric.swingset.ExportDataCheck")
+	proto.RegisterType((*Params)(nil), "agoric.swingset.Params")
+	proto.RegisterType((*State)(nil), "agoric.swingset.State")
+	proto.RegisterType((*StringBeans)(nil), "agoric.swingset.StringBeans")
+	proto.RegisterType((*PowerFlagFee)(nil), "agoric.swingset.PowerFlagFee")
+	proto.RegisterType((*QueueSize)(nil), "agoric.swingset.QueueSize")
+	proto.RegisterType((*Egress)(nil), "agoric.swingset.Egress")
+	proto.RegisterType((*SwingStoreArtifact)(nil), "agoric.swingset.SwingStoreArtifact")
 }
 
-func (m *QueueSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		i = encodeVarintSwingset(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x10
+func init() { proto.RegisterFile("agoric/swingset/swingset.proto", fileDescriptor_ff9c341e0de15f8b) }
+
+var fileDescriptor_ff9c341e0de15f8b = []byte{
+	// 1658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0xf7, 0xd8, 0x63, 0xef, 0x4c, 0xcd, 0x78, 0xbd, 0x5b, 0xbb, 0xd1, 0x4e, 0x16, 0xe2, 0x5a,
+	0x15, 0x42, 0x6b, 0x69, 0x95, 0x99, 0x6c, 0x12, 0x84, 0xe4, 0xc0, 0xc1, 0xed, 0xf5, 0xca, 0x0b,
+	0x09, 0x19, 0xca, 0x31, 0x42, 0x28, 0xa8, 0x55, 0xd3, 0x5d, 0xee, 0xe9, 0x75, 0x4f, 0x57, 0xa7,
+	0xaa, 0xda, 0x6b, 0xe7, 0xca, 0x01, 0x2e, 0x48, 0x88, 0x13, 0x07, 0x0e, 0xcb, 0x95, 0x3f, 0x82,
+	0x73, 0x8e, 0xb9, 0x81, 0x38, 0x34, 0xc8, 0xbe, 0xa0, 0x39, 0xce, 0x11, 0x09, 0x09, 0xd5, 0x47,
+	0x7f, 0xd8, 0xce, 0x61, 0xb3, 0x82, 0x9c, 0x66, 0xde, 0xef, 0xbd, 0x7a, 0xf5, 0xea, 0x57, 0xef,
+	0xbd, 0x7e, 0x05, 0x36, 0x69, 0xc4, 0x45, 0x1c, 0x8c, 0xe4, 0x8b, 0x38, 0x8d, 0x24, 0x53, 0xd5,
+	0x9f, 0x61, 0x26, 0xb8, 0xe2, 0x70, 0xc3, 0xea, 0x87, 0x25, 0x7c, 0xff, 0x6e, 0xc4, 0x23, 0x6e,
+	0x74, 0x23, 0xfd, 0xcf, 0x9a, 0xdd, 0xdf, 0x0c, 0xb8, 0x9c, 0x71, 0x39, 0x9a, 0x50, 0xc9, 0x46,
+	0x27, 0x8f, 0x27, 0x4c, 0xd1, 0xc7, 0xa3, 0x80, 0xc7, 0xa9, 0xd5, 0xe3, 0x5f, 0xb7, 0xc0, 0xad,
+	0x5d, 0x2e, 0xd8, 0xde, 0x09, 0x4d, 0xc6, 0x82, 0x67, 0x5c, 0xd2, 0x04, 0xde, 0x05, 0xab, 0x2a,
+	0x56, 0x09, 0x1b, 0xb4, 0x1e, 0xb4, 0xb6, 0xba, 0xc4, 0x0a, 0xf0, 0x01, 0xe8, 0x85, 0x4c, 0x06,
+	0x22, 0xce, 0x54, 0xcc, 0xd3, 0xc1, 0xb2, 0xd1, 0x35, 0x21, 0xf8, 0x3d, 0xb0, 0xca, 0x4e, 0x68,
+	0x22, 0x07, 0x2b, 0x0f, 0x56, 0xb6, 0x7a, 0xef, 0xbe, 0x39, 0xbc, 0x12, 0xe3, 0xb0, 0xdc, 0xc9,
+	0x6b, 0x7f, 0x51, 0xa0, 0x25, 0x62, 0xad, 0xb7, 0xdb, 0xbf, 0x79, 0x89, 0x96, 0xb0, 0x04, 0x9d,
+	0x52, 0x0d, 0xb7, 0x41, 0xff, 0xb9, 0xe4, 0xa9, 0x9f, 0x31, 0x31, 0x8b, 0x95, 0xb4, 0x71, 0x78,
+	0xf7, 0x16, 0x05, 0xba, 0x73, 0x46, 0x67, 0xc9, 0x36, 0x6e, 0x6a, 0x31, 0xe9, 0x69, 0x71, 0x6c,
+	0x25, 0xf8, 0x08, 0xdc, 0x78, 0x2e, 0xfd, 0x80, 0x87, 0xcc, 0x86, 0xe8, 0xc1, 0x45, 0x81, 0x6e,
+	0x96, 0xcb, 0x8c, 0x02, 0x93, 0xb5, 0xe7, 0x72, 0x57, 0xff, 0xf9, 0x53, 0x0b, 0x6c, 0x94, 0xbb,
+	0x7e, 0x9c, 0xab, 0x80, 0xcf, 0x18, 0x7c, 0x02, 0x7a, 0x99, 0x63, 0xc2, 0x8f, 0x43, 0xb3, 0x77,
+	0xdb, 0xfb, 0xce, 0xbc, 0x40, 0xa0, 0x84, 0x9f, 0x85, 0x8b, 0x02, 0xdd, 0xb6, 0x2e, 0x6b, 0x0c,
+	0x93, 0x86, 0x01, 0x1c, 0x80, 0x1b, 0x19, 0x4b, 0xc3, 0x38, 0x8d, 0x4c, 0x18, 0xeb, 0xa4, 0x14,
+	0xb5, 0x46, 0xe6, 0x41, 0xc0, 0xa4, 0xe6, 0xa9, 0xb5, 0xd5, 0x21, 0xa5, 0xa8, 0x79, 0x67, 0x42,
+	0x70, 0x31, 0x68, 0x5b, 0xde, 0x8d, 0x80, 0x2f, 0xda, 0xe0, 0xce, 0xd8, 0xae, 0xf5, 0xf2, 0x34,
+	0x4c, 0xd8, 0x61, 0x96, 0x70, 0x1a, 0xc2, 0x0c, 0x74, 0x65, 0x3e, 0x99, 0xc5, 0x4a, 0x31, 0x61,
+	0xa2, 0xec, 0x7b, 0x64, 0x5e, 0xa0, 0x1a, 0x5c, 0x14, 0xe8, 0x96, 0x0d, 0xb2, 0x82, 0xf0, 0xbf,
+	0x0b, 0xf4, 0x76, 0x14, 0xab, 0x69, 0x3e, 0x19, 0x06, 0x7c, 0x36, 0x72, 0xd9, 0x61, 0x7f, 0xde,
+	0x96, 0xe1, 0xf1, 0x48, 0x9d, 0x65, 0x4c, 0x0e, 0x77, 0x82, 0x60, 0x27, 0x0c, 0x05, 0x93, 0x92,
+	0xd4, 0xfe, 0x34, 0x33, 0x13, 0x13, 0x81, 0x3f, 0xa5, 0x72, 0xea, 0xe8, 0x35, 0xcc, 0x58, 0x78,
+	0x9f, 0xca, 0x69, 0xcd, 0x4c, 0x8d, 0x61, 0xd2, 0x30, 0x80, 0x9f, 0x80, 0x8d, 0x80, 0xcf, 0x32,
+	0xed, 0x9c, 0x85, 0xbe, 0x8c, 0x3f, 0x67, 0x86, 0x87, 0x15, 0xef, 0xd1, 0xbc, 0x40, 0x37, 0x6b,
+	0xd5, 0x41, 0xfc, 0x39, 0x5b, 0x14, 0xe8, 0x0d, 0xeb, 0xed, 0x32, 0x8e, 0xc9, 0x15, 0x43, 0xf8,
+	0x29, 0xb8, 0x9d, 0xa7, 0x57, 0xfd, 0xb6, 0x8d, 0xdf, 0xd1, 0xbc, 0x40, 0xb7, 0xf2, 0xf4, 0xf2,
+	0x82, 0x45, 0x81, 0xee, 0x59, 0xcf, 0x57, 0x35, 0x98, 0x5c, 0x33, 0x86, 0x1f, 0x82, 0x75, 0xc1,
+	0x02, 0x16, 0x9f, 0x94, 0x9e, 0x57, 0x8d, 0xe7, 0x87, 0xf3, 0x02, 0xf5, 0x4b, 0x85, 0xf3, 0xea,
+	0x32, 0xb4, 0x89, 0x62, 0x72, 0xc9, 0x48, 0xf3, 0x18, 0x4c, 0xf3, 0xf4, 0xd8, 0x0f, 0x78, 0x9e,
+	0xaa, 0xc1, 0x9a, 0xce, 0x0f, 0xcb, 0xa3, 0x81, 0x77, 0x35, 0x5a, 0xf3, 0x58, 0x63, 0x98, 0x34,
+	0x0c, 0x74, 0x4c, 0xec, 0x34, 0x8b, 0xc5, 0x99, 0x3f, 0x65, 0x71, 0x34, 0x55, 0x83, 0x1b, 0x75,
+	0x4c, 0x56, 0xb1, 0x6f, 0xf0, 0x3a, 0xa6, 0x26, 0x8a, 0xc9, 0x25, 0x23, 0xfc, 0xd7, 0x65, 0xb0,
+	0xf1, 0x2c, 0x95, 0x8a, 0x26, 0x09, 0x0b, 0x6d, 0x9e, 0xc1, 0x1f, 0x80, 0xae, 0xbb, 0x6f, 0x57,
+	0x07, 0x5d, 0x0f, 0xcd, 0x0b, 0xd4, 0xb1, 0xa0, 0xa9, 0x82, 0x8d, 0xe6, 0x5d, 0xeb, 0x1a, 0xa8,
+	0x94, 0x97, 0xf3, 0x73, 0xf9, 0x9b, 0xc8, 0xcf, 0x7d, 0xd0, 0x9f, 0x24, 0x3c, 0x38, 0x2e, 0x09,
+	0xb1, 0x69, 0xf5, 0xdd, 0x79, 0x81, 0x7a, 0x06, 0xaf, 0xf8, 0x80, 0x2e, 0xea, 0x1a, 0xc4, 0xa4,
+	0x69, 0xd2, 0xac, 0xde, 0xb6, 0xad, 0x51, 0x27, 0xc2, 0xfb, 0xa0, 0x43, 0x83, 0x80, 0x65, 0x8a,
+	0x85, 0x26, 0x09, 0x3a, 0xa4, 0x92, 0xeb, 0xfa, 0x5d, 0x6b, 0xd6, 0xef, 0x8f, 0xc0, 0xbd, 0xbd,
+	0x53, 0xc5, 0x52, 0x19, 0xf3, 0xf4, 0x63, 0xd3, 0x28, 0x9f, 0x32, 0xf6, 0x84, 0xa5, 0x7c, 0x06,
+	0x47, 0x60, 0x35, 0xd4, 0x7f, 0x1c, 0xb9, 0x6f, 0xce, 0x0b, 0x64, 0x81, 0x45, 0x81, 0xfa, 0x36,
+	0x46, 0x23, 0x62, 0x62, 0x61, 0xfc, 0x97, 0x65, 0xd0, 0x7f, 0x96, 0x4e, 0x78, 0x9e, 0x86, 0x63,
+	0x9a, 0x4b, 0x06, 0x7f, 0x0e, 0xd6, 0x67, 0x32, 0xf2, 0x35, 0x2b, 0x7e, 0x2e, 0x12, 0xdd, 0x2a,
+	0x57, 0xb6, 0xba, 0xde, 0xfb, 0xe7, 0x05, 0xea, 0x7d, 0x24, 0xa3, 0x4f, 0xce, 0x32, 0x76, 0x48,
+	0x3e, 0x94, 0x9a, 0x82, 0x99, 0x13, 0x45, 0x22, 0x6b, 0x0a, 0x1a, 0x20, 0x26, 0x4d, 0x13, 0xf8,
+	0x10, 0xac, 0xd0, 0x24, 0x31, 0x17, 0xd7, 0xf1, 0xde, 0x98, 0x17, 0x48, 0x8b, 0x8b, 0x02, 0x01,
+	0xbb, 0x90, 0x26, 0x09, 0x26, 0x1a, 0xd2, 0xac, 0xe7, 0xa9, 0x8a, 0x93, 0xaf, 0x60, 0xdd, 0xe0,
+	0x57, 0x59, 0x6f, 0x80, 0x98, 0x34, 0x4d, 0x20, 0x05, 0x77, 0x68, 0x92, 0xf0, 0x17, 0xfe, 0x34,
+	0x8e, 0xa6, 0x7e, 0x26, 0x62, 0x2e, 0x62, 0x75, 0x66, 0x6f, 0xc0, 0x7b, 0x3c, 0x2f, 0xd0, 0x6d,
+	0xa3, 0xde, 0x8f, 0xa3, 0xe9, 0xd8, 0x29, 0x17, 0x05, 0x1a, 0x54, 0x01, 0x5d, 0x56, 0x61, 0x72,
+	0xdd, 0x1c, 0xff, 0xb1, 0x0d, 0x80, 0xa7, 0x2f, 0xfa, 0x40, 0x51, 0x25, 0xaf, 0x65, 0x4c, 0xeb,
+	0xb5, 0x33, 0x46, 0x00, 0xa0, 0x7b, 0x46, 0xae, 0x04, 0x4f, 0xa5, 0x6b, 0x8d, 0x44, 0x7f, 0xe5,
+	0xfe, 0x5e, 0xa0, 0x87, 0xaf, 0x90, 0xd1, 0x87, 0x71, 0xaa, 0x4c, 0x07, 0xa8, 0x9c, 0x34, 0x3a,
+	0x40, 0x85, 0xe9, 0x0e, 0x50, 0x09, 0x90, 0x03, 0x30, 0x61, 0x34, 0x95, 0x7e, 0x2e, 0x59, 0x68,
+	0x78, 0xef, 0x7a, 0xe3, 0xaf, 0xbf, 0x67, 0xd7, 0x38, 0x39, 0x94, 0x2c, 0xac, 0x2b, 0xb2, 0x82,
+	0x30, 0xa9, 0xd5, 0xf0, 0x3d, 0xb0, 0x16, 0x08, 0x9a, 0x1e, 0x4b, 0x73, 0x27, 0x6d, 0xef, 0x5b,
+	0xf3, 0x02, 0x39, 0x64, 0x51, 0xa0, 0x75, 0x17, 0xad, 0x91, 0x31, 0x71, 0x0a, 0xdd, 0x99, 0x63,
+	0x9b, 0xb2, 0x7e, 0x26, 0x78, 0x60, 0x9a, 0xaa, 0x29, 0x9d, 0xb6, 0xed, 0xcc, 0x4e, 0x39, 0x2e,
+	0x75, 0x75, 0x67, 0xbe, 0xaa, 0xc1, 0xe4, 0x9a, 0xb1, 0xee, 0x82, 0x52, 0xf1, 0x2c, 0x63, 0xa1,
+	0xcf, 0xa8, 0x48, 0xce, 0x4c, 0xed, 0x75, 0x6c, 0x17, 0x74, 0x8a, 0x3d, 0x8d, 0xd7, 0x5d, 0xb0,
+	0x89, 0x62, 0x72, 0xc9, 0x08, 0xff, 0x6a, 0x19, 0x6c, 0xec, 0x9d, 0x66, 0x5c, 0xa8, 0x27, 0x54,
+	0xd1, 0xdd, 0x29, 0x0b, 0x8e, 0xff, 0x87, 0x39, 0xf2, 0x08, 0xb4, 0x1b, 0x1f, 0xce, 0x7b, 0xf3,
+	0x02, 0x19, 0x79, 0x51, 0xa0, 0x9e, 0x5d, 0x3a, 0x35, 0x1f, 0x4b, 0x03, 0xc2, 0xf7, 0xc1, 0x8d,
+	0x93, 0x99, 0xfd, 0xd0, 0xda, 0x9b, 0x35, 0x64, 0x9f, 0xcc, 0xdc, 0x47, 0xd6, 0x91, 0x6d, 0x65,
+	0x4c, 0x9c, 0x02, 0xee, 0xea, 0x34, 0x4c, 0x65, 0x2c, 0x15, 0x4b, 0x95, 0xab, 0x1c, 0xfb, 0x65,
+	0xa9, 0xd0, 0x66, 0x5e, 0x95, 0x98, 0xc9, 0xab, 0x4a, 0xf8, 0xed, 0x0a, 0x58, 0x1b, 0x53, 0x41,
+	0x67, 0xba, 0x40, 0x6e, 0xda, 0x14, 0xcb, 0x98, 0xf0, 0xf3, 0x34, 0x56, 0xa6, 0xc1, 0xf4, 0xde,
+	0xfd, 0xf6, 0xb5, 0xd9, 0xee, 0x40, 0x09, 0x3d, 0xa1, 0x68, 0x63, 0x37, 0xde, 0xf5, 0xcd, 0xca,
+	0x31, 0x13, 0x87, 0x69, 0xac, 0xe0, 0x67, 0xe0, 0xe6, 0x11, 0x63, 0xc6, 0x87, 0x2e, 0xed, 0x40,
+	0x8f, 0x67, 0x76, 0x4a, 0xb4, 0x79, 0x39, 0xd4, 0x23, 0xea, 0xd0, 0x8d, 0xa8, 0xc3, 0x5d, 0x1e,
+	0xa7, 0xde, 0x3b, 0xda, 0xcd, 0x9f, 0xff, 0x81, 0xb6, 0x5e, 0x21, 0x97, 0xf5, 0x02, 0x49, 0xfa,
+	0x47, 0x8c, 0xe9, 0xdd, 0xc6, 0x7a, 0x03, 0xf8, 0x0e, 0xb8, 0x3b, 0xe1, 0x5c, 0x49, 0x25, 0x68,
+	0xe6, 0x9f, 0x50, 0xe5, 0x07, 0x3c, 0x3d, 0x8a, 0x23, 0xcb, 0x27, 0x81, 0x95, 0xee, 0x67, 0x54,
+	0xed, 0x1a, 0x0d, 0xfc, 0x31, 0xd8, 0xc8, 0xf8, 0x0b, 0x26, 0xfc, 0xa3, 0x84, 0x46, 0xfe, 0x11,
+	0x63, 0x3a, 0xd3, 0x75, 0x94, 0x6f, 0x5d, 0x3b, 0xef, 0x58, 0xdb, 0x3d, 0x4d, 0x68, 0xf4, 0x94,
+	0x31, 0x77, 0xe0, 0xf5, 0xac, 0x81, 0x49, 0xf8, 0x43, 0xd0, 0xfd, 0x2c, 0x67, 0x39, 0xf3, 0x67,
+	0xf4, 0x74, 0xb0, 0x6a, 0xdc, 0xdc, 0xbf, 0xe6, 0xe6, 0xa7, 0xda, 0x42, 0x4f, 0x05, 0xce, 0x47,
+	0xc7, 0x2c, 0xf9, 0x88, 0x9e, 0x6e, 0x77, 0xfe, 0xf0, 0x12, 0x2d, 0xfd, 0xeb, 0x25, 0x6a, 0xe1,
+	0x9f, 0x80, 0x55, 0xdd, 0xae, 0x18, 0xdc, 0x03, 0xeb, 0xd6, 0xa3, 0x69, 0x6c, 0x2c, 0x1c, 0xb4,
+	0x5e, 0xd1, 0x6b, 0xdf, 0x2c, 0xdb, 0xb1, 0xab, 0x70, 0x02, 0x7a, 0x8d, 0xdb, 0x82, 0xb7, 0xc0,
+	0xca, 0x31, 0x3b, 0x73, 0xc3, 0xbe, 0xfe, 0x0b, 0xf7, 0xc0, 0xaa, 0xb9, 0x3b, 0x97, 0xa9, 0xa3,
+	0xaf, 0xd9, 0x53, 0x88, 0x5d, 0xbd, 0xdd, 0x36, 0xd1, 0xff, 0xbe, 0x05, 0xfa, 0x4d, 0xb2, 0xe0,
+	0x5b, 0x00, 0xd4, 0x24, 0xbb, 0x6d, 0xbb, 0x15, 0x75, 0xf0, 0x97, 0x60, 0xe5, 0x88, 0xfd, 0x5f,
+	0xb2, 0x43, 0xfb, 0x75, 0x41, 0x7d, 0x1f, 0x74, 0x2b, 0x8e, 0xbe, 0x82, 0x00, 0x08, 0xda, 0x66,
+	0xcc, 0xd3, 0xe7, 0x5f, 0x25, 0xe6, 0xbf, 0x5b, 0xf8, 0x9f, 0x16, 0x58, 0xdb, 0x8b, 0x84, 0x1e,
+	0xd7, 0x3f, 0x00, 0x9d, 0x34, 0x0e, 0x8e, 0x53, 0x3a, 0x63, 0xcd, 0xe9, 0xa8, 0xc4, 0xea, 0xe9,
+	0xa8, 0x44, 0x30, 0xa9, 0x94, 0xf0, 0x53, 0xd0, 0xce, 0x58, 0x35, 0x18, 0xed, 0xeb, 0x5e, 0xa0,
+	0xe5, 0xba, 0x17, 0x64, 0xec, 0xb5, 0xc6, 0x21, 0xe3, 0x05, 0x12, 0xd0, 0xab, 0x29, 0xb6, 0xef,
+	0xb1, 0xae, 0xf7, 0xf8, 0xbc, 0x40, 0xa0, 0xba, 0x09, 0x69, 0x5e, 0x34, 0x95, 0xd4, 0x78, 0xd1,
+	0x54, 0x98, 0x7e, 0xd1, 0x54, 0x82, 0x39, 0xff, 0x12, 0x56, 0x00, 0x1e, 0xe8, 0x2c, 0x3b, 0x50,
+	0x5c, 0xb0, 0x1d, 0xa1, 0xe2, 0x23, 0x1a, 0x98, 0xce, 0xd6, 0xa0, 0xc1, 0x74, 0x36, 0x47, 0x81,
+	0x3b, 0x8d, 0x3d, 0xbe, 0x01, 0xb5, 0x71, 0x48, 0x15, 0x75, 0x47, 0x37, 0xc6, 0x5a, 0xae, 0x8d,
+	0xb5, 0x84, 0x89, 0x01, 0xed, 0xae, 0xde, 0xe1, 0x17, 0xe7, 0x9b, 0xad, 0x2f, 0xcf, 0x37, 0x5b,
+	0xff, 0x3c, 0xdf, 0x6c, 0xfd, 0xee, 0x62, 0x73, 0xe9, 0xcb, 0x8b, 0xcd, 0xa5, 0xbf, 0x5d, 0x6c,
+	0x2e, 0xfd, 0xe2, 0x83, 0x06, 0x3d, 0x3b, 0xf6, 0xc9, 0x6c, 0x8b, 0xc1, 0xd0, 0x13, 0xf1, 0x84,
+	0xa6, 0x51, 0xc9, 0xdb, 0x69, 0xfd, 0x9a, 0x36, 0xbc, 0x4d, 0xd6, 0xcc, 0x23, 0xf8, 0xbd, 0xff,
+	0x0e, 0x00, 0x8b, 0x14, 0x90, 0xfa, 0x6d, 0x0f, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.BeansPerUnit) != len(that1.BeansPerUnit) {
+		return false
+	}
+	for i := range this.BeansPerUnit {
+		if !this.BeansPerUnit[i].Equal(&that1.BeansPerUnit[i]) {
+			return false
+		}
+	}
+	if len(this.FeeUnitPrice) != len(that1.FeeUnitPrice) {
+		return false
+	}
+	for i := range this.FeeUnitPrice {
+		if !this.FeeUnitPrice[i].Equal(&that1.FeeUnitPrice[i]) {
+			return false
+		}
+	}
+	if this.BootstrapVatConfig != that1.BootstrapVatConfig {
+		return false
+	}
+	if len(this.PowerFlagFees) != len(that1.PowerFlagFees) {
+		return false
+	}
+	for i := range this.PowerFlagFees {
+		if !this.PowerFlagFees[i].Equal(&that1.PowerFlagFees[i]) {
+			return false
+		}
+	}
+	if len(this.QueueMax) != len(that1.QueueMax) {
+		return false
+	}
+	for i := range this.QueueMax {
+		if !this.QueueMax[i].Equal(&that1.QueueMax[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *StringBeans) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Egress) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*StringBeans)
+	if !ok {
+		that2, ok := that.(StringBeans)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if !this.Beans.Equal(that1.Beans) {
+		return false
+	}
+	return true
+}
+func (this *PowerFlagFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PowerFlagFee)
+	if !ok {
+		that2, ok := that.(PowerFlagFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PowerFlag != that1.PowerFlag {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *QueueSize) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueueSize)
+	if !ok {
+		that2, ok := that.(QueueSize)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	return true
+}
+func (m *CoreEvalProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1104,43 +1369,48 @@ func (m *Egress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
+func (m *CoreEvalProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CoreEvalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PowerFlags) > 0 {
-		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PowerFlags[iNdEx])
-			copy(dAtA[i:], m.PowerFlags[iNdEx])
-			i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
+	if len(m.Evals) > 0 {
+		for iNdEx := len(m.Evals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Peer) > 0 {
-		i -= len(m.Peer)
-		copy(dAtA[i:], m.Peer)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Peer)))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Nickname) > 0 {
-		i -= len(m.Nickname)
-		copy(dAtA[i:], m.Nickname)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Nickname)))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Title)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SwingStoreArtifact) Marshal() (dAtA []byte, err error) {
+func (m *CoreEval) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1150,233 +1420,2017 @@ func (m *SwingStoreArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SwingStoreArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *CoreEval) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SwingStoreArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CoreEval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Data)))
+	if len(m.JsCode) > 0 {
+		i -= len(m.JsCode)
+		copy(dAtA[i:], m.JsCode)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsCode)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
+	if len(m.JsonPermits) > 0 {
+		i -= len(m.JsonPermits)
+		copy(dAtA[i:], m.JsonPermits)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsonPermits)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSwingset(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSwingset(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CoreEvalOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CoreEvalProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CoreEvalOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoreEvalOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Evals) > 0 {
-		for _, e := range m.Evals {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Pending != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CoreEval) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingBundleUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBundleUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBundleUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JsonPermits)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.JsCode)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if m.ChunkCount != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	if m.ReceivedSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ReceivedSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UncompressedSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CompressedSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.CompressedSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InstalledBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InstalledBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstalledBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BeansPerUnit) > 0 {
-		for _, e := range m.BeansPerUnit {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.FeeUnitPrice) > 0 {
-		for _, e := range m.FeeUnitPrice {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.BootstrapVatConfig)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.PowerFlagFees) > 0 {
-		for _, e := range m.PowerFlagFees {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
-		}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.QueueMax) > 0 {
-		for _, e := range m.QueueMax {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
-		}
+	if len(m.BundleId) > 0 {
+		i -= len(m.BundleId)
+		copy(dAtA[i:], m.BundleId)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BundleId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *State) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.QueueAllowed) > 0 {
-		for _, e := range m.QueueAllowed {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
-		}
+func (m *ExtensionOptionFeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StringBeans) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExtensionOptionFeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionFeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Beans.Size()
-	n += 1 + l + sovSwingset(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PowerFlagFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InboundPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PowerFlag)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if m.AllowHighPriority {
+		i--
+		if m.AllowHighPriority {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Fee) > 0 {
-		for _, e := range m.Fee {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	if m.UntilHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.UntilHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.All {
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeURLs) > 0 {
+		for iNdEx := len(m.MsgTypeURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeURLs[iNdEx])
+			copy(dAtA[i:], m.MsgTypeURLs[iNdEx])
+			i = encodeVarintSwingset(dAtA, i, uint64(len(m.MsgTypeURLs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueueSize) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BlockStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if m.StoppedEarly {
+		i--
+		if m.StoppedEarly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovSwingset(uint64(m.Size_))
+	if m.InboundProcessed != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.InboundProcessed))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if m.Cranks != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Cranks))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.BeansUsed.Size()
+		i -= size
+		if _, err := m.BeansUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Computrons.Size()
+		i -= size
+		if _, err := m.Computrons.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Egress) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExportDataCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportDataCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportDataCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Nickname)
+	if m.Consistent {
+		i--
+		if m.Consistent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.VmHash) > 0 {
+		i -= len(m.VmHash)
+		copy(dAtA[i:], m.VmHash)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.VmHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueueMax) > 0 {
+		for iNdEx := len(m.QueueMax) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueueMax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PowerFlagFees) > 0 {
+		for iNdEx := len(m.PowerFlagFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PowerFlagFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BootstrapVatConfig) > 0 {
+		i -= len(m.BootstrapVatConfig)
+		copy(dAtA[i:], m.BootstrapVatConfig)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BootstrapVatConfig)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeeUnitPrice) > 0 {
+		for iNdEx := len(m.FeeUnitPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeUnitPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BeansPerUnit) > 0 {
+		for iNdEx := len(m.BeansPerUnit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeansPerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *State) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueueAllowed) > 0 {
+		for iNdEx := len(m.QueueAllowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueueAllowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StringBeans) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StringBeans) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StringBeans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Beans.Size()
+		i -= size
+		if _, err := m.Beans.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PowerFlagFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerFlagFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerFlagFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PowerFlag) > 0 {
+		i -= len(m.PowerFlag)
+		copy(dAtA[i:], m.PowerFlag)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Egress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PowerFlags) > 0 {
+		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PowerFlags[iNdEx])
+			copy(dAtA[i:], m.PowerFlags[iNdEx])
+			i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nickname) > 0 {
+		i -= len(m.Nickname)
+		copy(dAtA[i:], m.Nickname)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Nickname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwingStoreArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwingset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwingset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CoreEvalProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.Evals) > 0 {
+		for _, e := range m.Evals {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CoreEval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JsonPermits)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.JsCode)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func (m *CoreEvalOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovSwingset(uint64(m.ProposalId))
+	}
+	if m.Pending != 0 {
+		n += 1 + sovSwingset(uint64(m.Pending))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingBundleUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.CompressedSize != 0 {
+		n += 1 + sovSwingset(uint64(m.CompressedSize))
+	}
+	if m.UncompressedSize != 0 {
+		n += 1 + sovSwingset(uint64(m.UncompressedSize))
+	}
+	if m.ReceivedSize != 0 {
+		n += 1 + sovSwingset(uint64(m.ReceivedSize))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovSwingset(uint64(m.ChunkCount))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *InstalledBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BundleId)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.BlockHeight))
+	}
+	if m.Pending {
+		n += 2
+	}
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionFeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func (m *InboundPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeURLs) > 0 {
+		for _, s := range m.MsgTypeURLs {
+			l = len(s)
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if m.All {
+		n += 2
+	}
+	if m.UntilHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.UntilHeight))
+	}
+	if m.AllowHighPriority {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlockStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.BlockHeight))
+	}
+	l = m.Computrons.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	l = m.BeansUsed.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	if m.Cranks != 0 {
+		n += 1 + sovSwingset(uint64(m.Cranks))
+	}
+	if m.InboundProcessed != 0 {
+		n += 1 + sovSwingset(uint64(m.InboundProcessed))
+	}
+	if m.StoppedEarly {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExportDataCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.BlockHeight))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.VmHash)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Consistent {
+		n += 2
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BeansPerUnit) > 0 {
+		for _, e := range m.BeansPerUnit {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if len(m.FeeUnitPrice) > 0 {
+		for _, e := range m.FeeUnitPrice {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	l = len(m.BootstrapVatConfig)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.PowerFlagFees) > 0 {
+		for _, e := range m.PowerFlagFees {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if len(m.QueueMax) > 0 {
+		for _, e := range m.QueueMax {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *State) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueueAllowed) > 0 {
+		for _, e := range m.QueueAllowed {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StringBeans) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = m.Beans.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
+func (m *PowerFlagFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PowerFlag)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovSwingset(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *Egress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nickname)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.PowerFlags) > 0 {
+		for _, s := range m.PowerFlags {
+			l = len(s)
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwingStoreArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovSwingset(uint64(l))
 	}
-	l = len(m.Peer)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
+func sovSwingset(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwingset(x uint64) (n int) {
+	return sovSwingset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreEvalProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreEvalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evals = append(m.Evals, CoreEval{})
+			if err := m.Evals[len(m.Evals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoreEval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreEval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreEval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPermits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonPermits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoreEvalOutcome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreEvalOutcome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreEvalOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingBundleUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingBundleUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingBundleUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedSize", wireType)
+			}
+			m.CompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
+			}
+			m.UncompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedSize", wireType)
+			}
+			m.ReceivedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.PowerFlags) > 0 {
-		for _, s := range m.PowerFlags {
-			l = len(s)
-			n += 1 + l + sovSwingset(uint64(l))
+	return nil
+}
+func (m *InstalledBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstalledBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstalledBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionFeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionFeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionFeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *SwingStoreArtifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovSwingset(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSwingset(x uint64) (n int) {
-	return sovSwingset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
+func (m *InboundPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1399,15 +3453,15 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoreEvalProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: InboundPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreEvalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InboundPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURLs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1435,11 +3489,139 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeURLs = append(m.MsgTypeURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilHeight", wireType)
+			}
+			m.UntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowHighPriority", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowHighPriority = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Computrons", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1467,13 +3649,15 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Computrons.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeansUsed", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1483,26 +3667,84 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evals = append(m.Evals, CoreEval{})
-			if err := m.Evals[len(m.Evals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BeansUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cranks", wireType)
+			}
+			m.Cranks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cranks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundProcessed", wireType)
+			}
+			m.InboundProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundProcessed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoppedEarly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StoppedEarly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
@@ -1524,7 +3766,7 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoreEval) Unmarshal(dAtA []byte) error {
+func (m *ExportDataCheck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1547,15 +3789,34 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoreEval: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportDataCheck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreEval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportDataCheck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsonPermits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1583,11 +3844,11 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JsonPermits = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1615,8 +3876,28 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JsCode = string(dAtA[iNdEx:postIndex])
+			m.VmHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consistent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Consistent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
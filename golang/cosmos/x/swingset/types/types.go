@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +27,16 @@ func NewEgress(nickname string, peer sdk.AccAddress, powerFlags []string) *Egres
 	}
 }
 
+// HasPowerFlag returns whether the egress has the power flag.
+func (e Egress) HasPowerFlag(powerFlag string) bool {
+	for _, pf := range e.PowerFlags {
+		if pf == powerFlag {
+			return true
+		}
+	}
+	return false
+}
+
 // Nat is analogous to @endo/nat
 // https://github.com/endojs/endo/blob/master/packages/nat
 func Nat(num float64) (uint64, error) {
@@ -105,3 +116,38 @@ func QueueSizeEntry(qs []QueueSize, key string) (int32, bool) {
 	}
 	return 0, false
 }
+
+// IsActive returns whether the pause is in effect at the given block height.
+func (p InboundPause) IsActive(height int64) bool {
+	if !p.All && len(p.MsgTypeURLs) == 0 {
+		return false
+	}
+	return p.UntilHeight == 0 || height < p.UntilHeight
+}
+
+// Pauses returns whether the pause applies to messages of the given type URL,
+// regardless of whether it is in effect.
+func (p InboundPause) Pauses(msgTypeURL string) bool {
+	if p.All {
+		return true
+	}
+	for _, url := range p.MsgTypeURLs {
+		if url == msgTypeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBasic runs stateless checks on the pause.
+func (p InboundPause) ValidateBasic() error {
+	if p.UntilHeight < 0 {
+		return fmt.Errorf("until height %d must not be negative", p.UntilHeight)
+	}
+	for i, url := range p.MsgTypeURLs {
+		if !strings.HasPrefix(url, "/") {
+			return fmt.Errorf("msg type URL %d %q must begin with /", i, url)
+		}
+	}
+	return nil
+}
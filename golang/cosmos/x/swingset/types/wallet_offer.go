@@ -0,0 +1,322 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/capdata"
+)
+
+var (
+	_ sdk.Msg                   = &MsgWalletOffer{}
+	_ vm.ControllerAdmissionMsg = &MsgWalletOffer{}
+)
+
+// invitationSpecSources are the InvitationSpec sources known to the smart
+// wallet.
+var invitationSpecSources = map[string]bool{
+	"purse":          true,
+	"contract":       true,
+	"agoricContract": true,
+	"continuing":     true,
+}
+
+// proposalKeys are the properties allowed in a Proposal.
+var proposalKeys = map[string]bool{
+	"give": true,
+	"want": true,
+	"exit": true,
+}
+
+// capdataIdentity keeps the decoded bigints and remotables, which only need
+// to be recognized for validation.
+var capdataIdentity = capdata.CapdataValueTransformations{
+	Bigint:    func(bigint *capdata.CapdataBigint) interface{} { return bigint },
+	Remotable: func(r *capdata.CapdataRemotable) interface{} { return r },
+}
+
+// smallcapsPart is a validated part of a structured wallet message.
+type smallcapsPart struct {
+	// The smallcaps body without its "#" prefix, with numbers kept verbatim.
+	body  interface{}
+	slots []interface{}
+	// The decoded value, for checking its shape.
+	decoded interface{}
+}
+
+// decodeSmallcapsPart decodes and validates the JSON-stringified smallcaps
+// CapData of a part of a structured wallet message.
+func decodeSmallcapsPart(name, serialized string) (*smallcapsPart, error) {
+	decoded, err := capdata.DecodeSerializedCapdata(serialized, capdataIdentity)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	var cd capdata.Capdata
+	if err := json.Unmarshal([]byte(serialized), &cd); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	if !strings.HasPrefix(cd.Body, "#") {
+		return nil, fmt.Errorf("%s: CapData must use the smallcaps encoding", name)
+	}
+	decoder := json.NewDecoder(strings.NewReader(cd.Body[1:]))
+	decoder.UseNumber()
+	var body interface{}
+	if err := decoder.Decode(&body); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return &smallcapsPart{body: body, slots: cd.Slots, decoded: decoded}, nil
+}
+
+// smallcapsComposer assembles smallcaps parts into a single CapData, merging
+// their slots.
+type smallcapsComposer struct {
+	slots []interface{}
+	// The merged index of each slot, by its JSON text.
+	indexOfSlot map[string]int
+	// The iface of each merged slot, if any.
+	ifaces map[int]string
+}
+
+func newSmallcapsComposer() *smallcapsComposer {
+	return &smallcapsComposer{
+		slots:       []interface{}{},
+		indexOfSlot: map[string]int{},
+		ifaces:      map[int]string{},
+	}
+}
+
+// add returns the body of the part with its slot references renumbered to the
+// merged slots.
+func (c *smallcapsComposer) add(name string, part *smallcapsPart) (interface{}, error) {
+	indices := make([]int, len(part.slots))
+	for i, slot := range part.slots {
+		bz, err := capdata.JsonMarshal(slot)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		index, ok := c.indexOfSlot[string(bz)]
+		if !ok {
+			index = len(c.slots)
+			c.slots = append(c.slots, slot)
+			c.indexOfSlot[string(bz)] = index
+		}
+		indices[i] = index
+	}
+	body, err := c.renumber(part.body, indices)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return body, nil
+}
+
+func (c *smallcapsComposer) renumber(encoded interface{}, indices []int) (interface{}, error) {
+	switch v := encoded.(type) {
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, elem := range v {
+			renumbered, err := c.renumber(elem, indices)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = renumbered
+		}
+		return out, nil
+	case map[string]interface{}:
+		// Record keys cannot be slot references.
+		out := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			renumbered, err := c.renumber(elem, indices)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = renumbered
+		}
+		return out, nil
+	case string:
+		if !strings.HasPrefix(v, "$") {
+			return v, nil
+		}
+		indexStr, iface, hasIface := strings.Cut(v[1:], ".")
+		slotIndex, err := strconv.Atoi(indexStr)
+		if err != nil || slotIndex < 0 || slotIndex >= len(indices) {
+			return nil, fmt.Errorf("invalid slot index: %q", v)
+		}
+		index := indices[slotIndex]
+		if !hasIface {
+			return fmt.Sprintf("$%d", index), nil
+		}
+		if prior, ok := c.ifaces[index]; ok && prior != iface {
+			return nil, fmt.Errorf("slot iface mismatch: %q", v)
+		}
+		c.ifaces[index] = iface
+		return fmt.Sprintf("$%d.%s", index, iface), nil
+	default:
+		return v, nil
+	}
+}
+
+// serialize returns the JSON-stringified smallcaps CapData of a body composed
+// of the added parts.
+func (c *smallcapsComposer) serialize(body interface{}) (string, error) {
+	bz, err := capdata.JsonMarshal(body)
+	if err != nil {
+		return "", err
+	}
+	bz, err = capdata.JsonMarshal(capdata.Capdata{Body: "#" + string(bz), Slots: c.slots})
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// encodeSmallcapsString returns the smallcaps encoding of a string.
+func encodeSmallcapsString(str string) string {
+	if len(str) > 0 && str[0] >= '!' && str[0] <= '-' {
+		return "!" + str
+	}
+	return str
+}
+
+func NewMsgWalletOffer(owner sdk.AccAddress, offerId, invitationSpec, proposal, offerArgs string) *MsgWalletOffer {
+	return &MsgWalletOffer{
+		Owner:          owner,
+		OfferId:        offerId,
+		InvitationSpec: invitationSpec,
+		Proposal:       proposal,
+		OfferArgs:      offerArgs,
+	}
+}
+
+// SpendAction returns the JSON-stringified "executeOffer" bridge action that
+// MsgWalletSpendAction would carry for the offer, as expected by the smart
+// wallet.
+func (msg MsgWalletOffer) SpendAction() (string, error) {
+	invitationSpec, err := decodeSmallcapsPart("invitationSpec", msg.InvitationSpec)
+	if err != nil {
+		return "", err
+	}
+	spec, ok := invitationSpec.decoded.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("invitationSpec: must be a record")
+	}
+	source, _ := spec["source"].(string)
+	if !invitationSpecSources[source] {
+		return "", fmt.Errorf("invitationSpec: unknown source %q", source)
+	}
+
+	proposal, err := decodeSmallcapsPart("proposal", msg.Proposal)
+	if err != nil {
+		return "", err
+	}
+	proposalRecord, ok := proposal.decoded.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("proposal: must be a record")
+	}
+	for k := range proposalRecord {
+		if !proposalKeys[k] {
+			return "", fmt.Errorf("proposal: unexpected property %q", k)
+		}
+	}
+
+	var offerArgs *smallcapsPart
+	if msg.OfferArgs != "" {
+		offerArgs, err = decodeSmallcapsPart("offerArgs", msg.OfferArgs)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := offerArgs.decoded.(map[string]interface{}); !ok {
+			return "", fmt.Errorf("offerArgs: must be a record")
+		}
+	}
+
+	composer := newSmallcapsComposer()
+	offer := map[string]interface{}{
+		"id": encodeSmallcapsString(msg.OfferId),
+	}
+	if offer["invitationSpec"], err = composer.add("invitationSpec", invitationSpec); err != nil {
+		return "", err
+	}
+	if offer["proposal"], err = composer.add("proposal", proposal); err != nil {
+		return "", err
+	}
+	if offerArgs != nil {
+		if offer["offerArgs"], err = composer.add("offerArgs", offerArgs); err != nil {
+			return "", err
+		}
+	}
+	return composer.serialize(map[string]interface{}{
+		"method": "executeOffer",
+		"offer":  offer,
+	})
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgWalletOffer) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+
+	spendAction, err := msg.SpendAction()
+	if err != nil {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	err = checkSmartWalletProvisioned(ctx, keeper, msg.Owner)
+	if err != nil {
+		return err
+	}
+
+	return chargeAdmission(ctx, keeper, msg.Owner, []string{spendAction}, 0)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgWalletOffer) GetInboundMsgCount() int32 {
+	return 1
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgWalletOffer) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return false, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+
+	return keeper.IsHighPriorityAddress(ctx, msg.Owner)
+}
+
+func (msg MsgWalletOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgWalletOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// Route should return the name of the module
+func (msg MsgWalletOffer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgWalletOffer) Type() string { return "wallet_offer" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWalletOffer) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Owner address cannot be empty")
+	}
+	if len(strings.TrimSpace(msg.OfferId)) == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Offer id cannot be empty")
+	}
+	if _, err := msg.SpendAction(); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid wallet offer: %s", err)
+	}
+	return nil
+}
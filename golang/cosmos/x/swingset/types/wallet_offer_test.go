@@ -0,0 +1,105 @@
+package types
+
+import (
+	"testing"
+)
+
+const (
+	testInvitationSpec = `{"body":"#{\"instance\":\"$0.Alleged: instance\",\"publicInvitationMaker\":\"makeSwapInvitation\",\"source\":\"contract\"}","slots":["board0123"]}`
+	testProposal       = `{"body":"#{\"give\":{\"In\":{\"brand\":\"$0.Alleged: IST brand\",\"value\":\"+1000\"}},\"want\":{\"Out\":{\"brand\":\"$1.Alleged: BLD brand\",\"value\":\"+20\"}}}","slots":["board0257","board0566"]}`
+	testOfferArgs      = `{"body":"#{\"brand\":\"$0\",\"slippage\":0.5}","slots":["board0566"]}`
+)
+
+func TestWalletOffer(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgWalletOffer
+		shouldErr bool
+	}{
+		{
+			name:      "empty",
+			msg:       &MsgWalletOffer{},
+			shouldErr: true,
+		},
+		{
+			name: "normal",
+			msg:  NewMsgWalletOffer(addr, "offer-1", testInvitationSpec, testProposal, ""),
+		},
+		{
+			name: "offer args",
+			msg:  NewMsgWalletOffer(addr, "offer-1", testInvitationSpec, testProposal, testOfferArgs),
+		},
+		{
+			name:      "empty offer id",
+			msg:       NewMsgWalletOffer(addr, "", testInvitationSpec, testProposal, ""),
+			shouldErr: true,
+		},
+		{
+			name:      "bad json",
+			msg:       NewMsgWalletOffer(addr, "offer-1", "foo", testProposal, ""),
+			shouldErr: true,
+		},
+		{
+			name:      "legacy encoding",
+			msg:       NewMsgWalletOffer(addr, "offer-1", `{"body":"{\"source\":\"purse\"}","slots":[]}`, testProposal, ""),
+			shouldErr: true,
+		},
+		{
+			name:      "unknown source",
+			msg:       NewMsgWalletOffer(addr, "offer-1", `{"body":"#{\"source\":\"pocket\"}","slots":[]}`, testProposal, ""),
+			shouldErr: true,
+		},
+		{
+			name:      "bad slot index",
+			msg:       NewMsgWalletOffer(addr, "offer-1", testInvitationSpec, `{"body":"#{\"give\":\"$1\"}","slots":["board0257"]}`, ""),
+			shouldErr: true,
+		},
+		{
+			name:      "bad bigint",
+			msg:       NewMsgWalletOffer(addr, "offer-1", testInvitationSpec, `{"body":"#{\"give\":\"+1.5\"}","slots":[]}`, ""),
+			shouldErr: true,
+		},
+		{
+			name:      "unexpected proposal property",
+			msg:       NewMsgWalletOffer(addr, "offer-1", testInvitationSpec, `{"body":"#{\"take\":{}}","slots":[]}`, ""),
+			shouldErr: true,
+		},
+		{
+			name:      "offer args not a record",
+			msg:       NewMsgWalletOffer(addr, "offer-1", testInvitationSpec, testProposal, `{"body":"#[]","slots":[]}`),
+			shouldErr: true,
+		},
+		{
+			name:      "iface mismatch",
+			msg:       NewMsgWalletOffer(addr, "offer-1", testInvitationSpec, testProposal, `{"body":"#{\"brand\":\"$0.Alleged: IST brand\"}","slots":["board0566"]}`),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestWalletOfferSpendAction(t *testing.T) {
+	msg := NewMsgWalletOffer(addr, "#1", testInvitationSpec, testProposal, testOfferArgs)
+	got, err := msg.SpendAction()
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	want := `{"body":"#{\"method\":\"executeOffer\",\"offer\":{` +
+		`\"id\":\"!#1\",` +
+		`\"invitationSpec\":{\"instance\":\"$0.Alleged: instance\",\"publicInvitationMaker\":\"makeSwapInvitation\",\"source\":\"contract\"},` +
+		`\"offerArgs\":{\"brand\":\"$2\",\"slippage\":0.5},` +
+		`\"proposal\":{\"give\":{\"In\":{\"brand\":\"$1.Alleged: IST brand\",\"value\":\"+1000\"}},\"want\":{\"Out\":{\"brand\":\"$2.Alleged: BLD brand\",\"value\":\"+20\"}}}` +
+		`}}","slots":["board0123","board0257","board0566"]}`
+	if got != want {
+		t.Errorf("got spend action\n%s\nwant\n%s", got, want)
+	}
+}
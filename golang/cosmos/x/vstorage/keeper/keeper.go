@@ -11,8 +11,10 @@ import (
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	db "github.com/tendermint/tm-db"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
@@ -251,7 +253,11 @@ func (k Keeper) GetEntry(ctx sdk.Context, path string) agoric.KVEntry {
 	//fmt.Printf("GetEntry(%s)\n", path);
 	store := ctx.KVStore(k.storeKey)
 	encodedKey := types.PathToEncodedKey(path)
-	rawValue := store.Get(encodedKey)
+	return decodeEntry(path, store.Get(encodedKey))
+}
+
+// decodeEntry returns the entry at path given its raw store value.
+func decodeEntry(path string, rawValue []byte) agoric.KVEntry {
 	if len(rawValue) == 0 {
 		return agoric.NewKVEntryWithNoValue(path)
 	}
@@ -287,6 +293,26 @@ func (k Keeper) GetChildren(ctx sdk.Context, path string) *types.Children {
 	return &children
 }
 
+// PaginateChildren calls onChild with the entries of the children of a path
+// in key order, as selected by the page request like query.FilteredPaginate:
+// onChild returns whether the entry matches, and must only accumulate it if
+// told to.
+func (k Keeper) PaginateChildren(
+	ctx sdk.Context,
+	path string,
+	pageReq *query.PageRequest,
+	onChild func(entry agoric.KVEntry, accumulate bool) (bool, error),
+) (*query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PathToChildrenPrefix(path))
+	return query.FilteredPaginate(store, pageReq, func(key, value []byte, accumulate bool) (bool, error) {
+		childPath := string(key)
+		if path != "" {
+			childPath = path + types.PathSeparator + childPath
+		}
+		return onChild(decodeEntry(childPath, value), accumulate)
+	})
+}
+
 // HasStorage tells if a given path has data.  Some storage nodes have no data
 // (just an empty string) and exist only to provide linkage to subnodes with
 // data.
@@ -47,6 +47,7 @@ import {
   performStateSyncImport,
   validateImporterOptions,
 } from './import-kernel-db.js';
+import { spawnSwingStoreExportDataHash } from './helpers/export-data-hash.js';
 
 // eslint-disable-next-line no-unused-vars
 let whenHellFreezesOver = null;
@@ -718,6 +719,11 @@ export default async function main(progname, args, { env, homedir, agcc }) {
         return resultP;
       }
 
+      // Like snapshots, export data checks run outside of blocks.
+      case ActionType.SWING_STORE_EXPORT_DATA_HASH: {
+        return spawnSwingStoreExportDataHash(stateDBDir, { fork });
+      }
+
       default: {
         if (!blockingSend) throw Fail`Swingset not initialized`;
 
@@ -6,6 +6,7 @@ import '@endo/init/unsafe-fast.js';
 
 import os from 'os';
 import process from 'process';
+import { createHash } from 'crypto';
 import fsPower from 'fs/promises';
 import pathPower from 'path';
 import { fileURLToPath } from 'url';
@@ -90,6 +91,8 @@ export const checkExportDataMode = (mode, isImport = false) => {
  *   "export data".
  * The `artifacts` field is a list of [artifactName, fileName] pairs
  *   (where the content of each artifact is stored in the corresponding file).
+ * An export layered on a `base` export has an empty file name for the
+ *   artifacts unchanged since the base, which are read from the base export.
  * For more details, see packages/swing-store/docs/data-export.md
  *
  * @typedef {object} StateSyncManifest
@@ -98,8 +101,35 @@ export const checkExportDataMode = (mode, isImport = false) => {
  * @property {string} [data] file name containing the swingStore "export data"
  * @property {Array<[artifactName: string, fileName: string]>} artifacts
  *   List of swingStore export artifacts which can be validated by the export data
+ * @property {ExportFileDigest[]} [artifactDigests] digests of the artifacts,
+ *   in the same order as `artifacts`
+ * @property {StateSyncManifestBase} [base] the base export of a layered export
  */
 
+/**
+ * @typedef {object} ExportFileDigest
+ * @property {number} size
+ * @property {string} sha256 hex encoded
+ */
+
+/**
+ * @typedef {object} StateSyncManifestBase
+ * @property {string} dir the directory of the base export
+ * @property {number} [blockHeight]
+ * @property {ExportFileDigest} manifestDigest digest of the base export manifest
+ */
+
+/**
+ * Like digestOf in golang/cosmos/x/swingset/keeper/swing_store_exports_handler.go
+ *
+ * @param {Uint8Array} data
+ * @returns {ExportFileDigest}
+ */
+const digestOf = data => ({
+  size: data.length,
+  sha256: createHash('sha256').update(data).digest('hex'),
+});
+
 /**
  * @typedef {object} StateSyncExporter
  * @property {() => number | undefined} getBlockHeight
@@ -115,6 +145,7 @@ export const checkExportDataMode = (mode, isImport = false) => {
  * @property {number} [blockHeight] block height to check for
  * @property {SwingStoreArtifactMode} [artifactMode] the level of artifacts to include in the export
  * @property {SwingStoreExportDataMode} [exportDataMode] include a synthetic artifact for the export data in the export
+ * @property {string} [baseExportDir] the directory of a previous export with artifact digests, from which the unchanged artifacts are referenced instead of written
  */
 
 /**
@@ -132,6 +163,9 @@ export const validateExporterOptions = options => {
     Fail`optional blockHeight option not a number`;
   checkArtifactMode(options.artifactMode);
   checkExportDataMode(options.exportDataMode);
+  options.baseExportDir === undefined ||
+    typeof options.baseExportDir === 'string' ||
+    Fail`optional baseExportDir option not a string`;
 
   options.includeExportData === undefined ||
     Fail`deprecated includeExportData option found`;
@@ -141,16 +175,23 @@ export const validateExporterOptions = options => {
 /**
  * @param {StateSyncExporterOptions} options
  * @param {object} powers
- * @param {Pick<import('fs/promises'), 'open' | 'writeFile'>} powers.fs
+ * @param {Pick<import('fs/promises'), 'open' | 'readFile' | 'writeFile'>} powers.fs
  * @param {import('path')['resolve']} powers.pathResolve
  * @param {typeof import('@agoric/swing-store')['makeSwingStoreExporter']} [powers.makeSwingStoreExporter]
  * @param {null | ((...args: any[]) => void)} [powers.log]
  * @returns {StateSyncExporter}
  */
 export const initiateSwingStoreExport = (
-  { stateDir, exportDir, blockHeight, artifactMode, exportDataMode },
   {
-    fs: { open, writeFile },
+    stateDir,
+    exportDir,
+    blockHeight,
+    artifactMode,
+    exportDataMode,
+    baseExportDir,
+  },
+  {
+    fs: { open, readFile, writeFile },
     pathResolve,
     makeSwingStoreExporter: makeExporter = makeSwingStoreExporter,
     log = console.log,
@@ -218,14 +259,58 @@ export const initiateSwingStoreExport = (
     }
     abortIfStopped();
 
+    /** @type {Map<string, ExportFileDigest> | undefined} */
+    let baseDigests;
+    if (baseExportDir && artifactMode !== 'none') {
+      const baseDir = pathResolve(baseExportDir);
+      const rawBaseManifest = await readFile(
+        pathResolve(baseDir, ExportManifestFileName),
+      );
+      /** @type {StateSyncManifest} */
+      const baseManifest = JSON.parse(rawBaseManifest.toString());
+      const { artifacts: baseArtifacts, artifactDigests } = baseManifest;
+      artifactDigests?.length === baseArtifacts.length ||
+        Fail`base export ${q(baseDir)} has no artifact digests`;
+      baseDigests = new Map(
+        baseArtifacts.map(([name], i) => [name, artifactDigests[i]]),
+      );
+      manifest.base = {
+        dir: baseDir,
+        blockHeight: baseManifest.blockHeight,
+        manifestDigest: digestOf(rawBaseManifest),
+      };
+      manifest.artifactDigests = [];
+    }
+
     if (artifactMode !== 'none') {
       for await (const artifactName of swingStoreExporter.getArtifactNames()) {
         abortIfStopped();
-        log?.(`Writing artifact: ${artifactName}`);
         const artifactData = swingStoreExporter.getArtifact(artifactName);
-        // Use artifactName as the file name as we trust swingStore to generate
-        // artifact names that are valid file names.
-        await writeFile(pathResolve(exportDir, artifactName), artifactData);
+        if (baseDigests && manifest.artifactDigests) {
+          const chunks = [];
+          for await (const chunk of artifactData) {
+            chunks.push(chunk);
+          }
+          const data = Buffer.concat(chunks);
+          const digest = digestOf(data);
+          manifest.artifactDigests.push(digest);
+          const baseDigest = baseDigests.get(artifactName);
+          if (
+            baseDigest?.size === digest.size &&
+            baseDigest.sha256 === digest.sha256
+          ) {
+            // Unchanged since the base export, so read from there.
+            manifest.artifacts.push([artifactName, '']);
+            continue;
+          }
+          log?.(`Writing artifact: ${artifactName}`);
+          await writeFile(pathResolve(exportDir, artifactName), data);
+        } else {
+          log?.(`Writing artifact: ${artifactName}`);
+          // Use artifactName as the file name as we trust swingStore to
+          // generate artifact names that are valid file names.
+          await writeFile(pathResolve(exportDir, artifactName), artifactData);
+        }
         manifest.artifacts.push([artifactName, artifactName]);
       }
     }
@@ -316,6 +401,8 @@ export const main = async (
   const exportDataMode = processValue.getFlag('export-data-mode');
   checkExportDataMode(exportDataMode);
 
+  const baseExportDir = processValue.getFlag('base-export-dir');
+
   if (
     processValue.getBoolean({ flagName: 'include-export-data' }) !== undefined
   ) {
@@ -342,6 +429,7 @@ export const main = async (
       blockHeight: checkBlockHeight,
       artifactMode,
       exportDataMode,
+      baseExportDir,
     },
     {
       fs,
@@ -382,7 +470,14 @@ export const main = async (
  * @returns {StateSyncExporter}
  */
 export const spawnSwingStoreExport = (
-  { stateDir, exportDir, blockHeight, artifactMode, exportDataMode },
+  {
+    stateDir,
+    exportDir,
+    blockHeight,
+    artifactMode,
+    exportDataMode,
+    baseExportDir,
+  },
   { fork, verbose },
 ) => {
   const args = ['--state-dir', stateDir, '--export-dir', exportDir];
@@ -399,6 +494,10 @@ export const spawnSwingStoreExport = (
     args.push('--export-data-mode', exportDataMode);
   }
 
+  if (baseExportDir) {
+    args.push('--base-export-dir', baseExportDir);
+  }
+
   if (verbose) {
     args.push('--verbose');
   }
@@ -0,0 +1,38 @@
+#! /usr/bin/env node
+// @ts-check
+
+// Hash the "export data" of a swing-store in a child process of the chain, see
+// spawnSwingStoreExportDataHash.
+
+import '@endo/init/unsafe-fast.js';
+
+import process from 'process';
+
+import { Fail } from '@endo/errors';
+
+import { hashSwingStoreExportData } from './helpers/export-data-hash.js';
+import { isEntrypoint } from './helpers/is-entrypoint.js';
+import { makeProcessValue } from './helpers/process-value.js';
+
+if (isEntrypoint(import.meta.url)) {
+  const processValue = makeProcessValue({
+    env: process.env,
+    args: process.argv.splice(2),
+  });
+  const stateDir = processValue.getFlag('state-dir');
+  const send = process.send
+    ? Function.prototype.bind.call(process.send, process)
+    : console.log;
+  Promise.resolve()
+    .then(() => {
+      stateDir || Fail`--state-dir is required`;
+      return hashSwingStoreExportData(/** @type {string} */ (stateDir));
+    })
+    .then(
+      result => send({ ...result }),
+      error => {
+        send({ error });
+        process.exitCode = 1;
+      },
+    );
+}
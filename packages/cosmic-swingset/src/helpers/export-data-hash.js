@@ -0,0 +1,103 @@
+// @ts-check
+
+import { createHash } from 'node:crypto';
+import { fileURLToPath } from 'url';
+
+import { makeSwingStoreExporter } from '@agoric/swing-store';
+
+/**
+ * Like the Go encoding/json package, escape the HTML characters as well as the
+ * line and paragraph separators, which JSON.stringify emits verbatim. Go's
+ * KVEntry.MarshalJSON uses json.Marshal, so the HTML characters are escaped
+ * even though SwingStoreExportDataHash disables HTML escaping.
+ *
+ * @param {unknown} value
+ */
+export const goJsonStringify = value =>
+  JSON.stringify(value).replace(
+    /[<>&\u2028\u2029]/g,
+    c => `\\u${c.charCodeAt(0).toString(16).padStart(4, '0')}`,
+  );
+harden(goJsonStringify);
+
+/**
+ * Hash "export data" entries in the format of SwingStoreExportDataHash in
+ * golang/cosmos/x/swingset/genesis.go: the sha256 of the JSON lines of the
+ * [key, value] entries in the order of the UTF-8 bytes of their key, which is
+ * the order of the Go KVStore iterator.
+ *
+ * @param {Iterable<readonly [key: string, value?: string | null]>} entries
+ */
+export const hashExportDataEntries = entries => {
+  // Comparing UTF-16 strings would misorder characters outside of the BMP.
+  const sorted = [...entries]
+    .map(entry => /** @type {const} */ ([Buffer.from(entry[0]), entry]))
+    .sort(([a], [b]) => Buffer.compare(a, b));
+
+  const hasher = createHash('sha256');
+  for (const [_key, [key, value]] of sorted) {
+    const entry = value == null ? [key] : [key, value];
+    hasher.update(`${goJsonStringify(entry)}\n`);
+  }
+  return `sha256:${hasher.digest('hex')}`;
+};
+harden(hashExportDataEntries);
+
+/**
+ * Hash the "export data" of the committed state of a swing-store.
+ *
+ * The entries are collected and sorted in memory, so this should run in its
+ * own process, see spawnSwingStoreExportDataHash.
+ *
+ * @param {string} stateDBDir
+ * @returns {Promise<{ blockHeight: number, hash: string }>}
+ */
+export const hashSwingStoreExportData = async stateDBDir => {
+  const exporter = makeSwingStoreExporter(stateDBDir);
+  try {
+    const blockHeight = Number(exporter.getHostKV('host.height') || 0);
+    const entries = [];
+    for await (const entry of exporter.getExportData()) {
+      entries.push(entry);
+    }
+    return harden({ blockHeight, hash: hashExportDataEntries(entries) });
+  } finally {
+    await exporter.close();
+  }
+};
+harden(hashSwingStoreExportData);
+
+/**
+ * Hash the "export data" of a swing-store in a child process, keeping the
+ * block manager responsive while the entries are sorted and hashed.
+ *
+ * @param {string} stateDBDir
+ * @param {{ fork: typeof import('child_process').fork }} powers
+ * @returns {Promise<{ blockHeight: number, hash: string }>}
+ */
+export const spawnSwingStoreExportDataHash = (stateDBDir, { fork }) =>
+  new Promise((resolve, reject) => {
+    const args = ['--state-dir', stateDBDir];
+    const entrypoint = new URL('../hash-export-data.js', import.meta.url);
+    const cp = fork(fileURLToPath(entrypoint), args, {
+      serialization: 'advanced', // To get error objects serialized
+    });
+    let result;
+    cp.on('error', reject)
+      .on('message', msg => {
+        result = msg;
+      })
+      .on('exit', (code, signal) => {
+        if (!result) {
+          reject(
+            Error(`Process exited before done. code=${code}, signal=${signal}`),
+          );
+        } else if (result.error) {
+          reject(result.error);
+        } else {
+          const { blockHeight, hash } = result;
+          resolve(harden({ blockHeight, hash }));
+        }
+      });
+  });
+harden(spawnSwingStoreExportDataHash);
@@ -5,6 +5,7 @@
 // so let the JS tooling know about it by importing it here.
 import '@agoric/builders';
 
+import { createHash } from 'node:crypto';
 import anylogger from 'anylogger';
 
 import { assert, Fail } from '@endo/errors';
@@ -248,9 +249,23 @@ export async function buildSwingset(
  *   shouldRun(): boolean;
  *   remainingBeans(): bigint | undefined;
  *   totalBeans(): bigint;
+ *   totalComputrons(): bigint;
+ *   cranks(): number;
  * }} ChainRunPolicy
  */
 
+/**
+ * The execution statistics of a block, returned to the chain in the reply to
+ * END_BLOCK (see BlockStats in golang/cosmos/proto/agoric/swingset).
+ *
+ * @typedef {object} EndBlockStats
+ * @property {string} computrons
+ * @property {string} beansUsed
+ * @property {number} cranks
+ * @property {number} inboundProcessed
+ * @property {boolean} stoppedEarly
+ */
+
 /**
  * @typedef {object} BeansPerUnit
  * @property {bigint} blockComputeLimit
@@ -275,6 +290,8 @@ function computronCounter(
   assert.typeof(vatCreation, 'bigint');
   assert.typeof(xsnapComputron, 'bigint');
   let totalBeans = 0n;
+  let totalComputrons = 0n;
+  let cranks = 0;
   const shouldRun = () => ignoreBlockLimit || totalBeans < blockComputeLimit;
   const remainingBeans = () =>
     ignoreBlockLimit ? undefined : blockComputeLimit - totalBeans;
@@ -286,8 +303,10 @@ function computronCounter(
     },
     crankComplete(details = {}) {
       assert.typeof(details, 'object');
+      cranks += 1;
       if (details.computrons) {
         assert.typeof(details.computrons, 'bigint');
+        totalComputrons += details.computrons;
 
         // TODO: xsnapComputron should not be assumed here.
         // Instead, SwingSet should describe the computron model it uses.
@@ -297,6 +316,7 @@ function computronCounter(
     },
     crankFailed() {
       const failedComputrons = 1000000n; // who knows, 1M is as good as anything
+      cranks += 1;
       totalBeans += failedComputrons * xsnapComputron;
       return shouldRun();
     },
@@ -308,6 +328,12 @@ function computronCounter(
     totalBeans() {
       return totalBeans;
     },
+    totalComputrons() {
+      return totalComputrons;
+    },
+    cranks() {
+      return cranks;
+    },
   });
   return policy;
 }
@@ -475,6 +501,9 @@ export async function launch({
     const chainSends = await clearChainSends();
     kvStore.set(getHostKey('height'), `${blockHeight}`);
     kvStore.set(getHostKey('chainSends'), JSON.stringify(chainSends));
+    if (endBlockStats) {
+      kvStore.set(getHostKey('endBlockStats'), JSON.stringify(endBlockStats));
+    }
 
     await commit();
   }
@@ -506,6 +535,41 @@ export async function launch({
     bridgeInbound(source, body);
   }
 
+  /**
+   * Report the outcome of a bundle installation to the chain, identifying the
+   * bundle like MsgInstallBundle.BundleID in golang/cosmos/x/swingset/types.
+   *
+   * @param {any} bundle
+   * @param {string} bundleJson
+   * @param {unknown} error
+   */
+  function reportBundleInstallOutcome(bundle, bundleJson, error) {
+    if (!bridgeOutbound) {
+      return;
+    }
+    const { moduleFormat, endoZipBase64Sha512 } = bundle;
+    const bundleId =
+      moduleFormat === 'endoZipBase64' && endoZipBase64Sha512
+        ? `b1-${endoZipBase64Sha512}`
+        : `b0-${createHash('sha512').update(bundleJson).digest('hex')}`;
+    try {
+      bridgeOutbound(BRIDGE_ID.SWINGSET, {
+        method: 'bundleInstallOutcome',
+        args: [
+          {
+            bundleId,
+            accepted: error === null,
+            error: error === null ? '' : `${error}`,
+          },
+        ],
+      });
+    } catch (e) {
+      // The chain may not know of the installation, e.g. if it was queued
+      // before the chain started recording them.
+      blockManagerConsole.warn('bundleInstallOutcome warn:', e);
+    }
+  }
+
   async function installBundle(bundleJson) {
     let bundle;
     try {
@@ -523,6 +587,8 @@ export async function launch({
 
     const { endoZipBase64Sha512 } = bundle;
 
+    reportBundleInstallOutcome(bundle, bundleJson, error);
+
     if (installationPublisher === undefined) {
       return;
     }
@@ -554,6 +620,9 @@ export async function launch({
   let saveTime = 0;
   let endBlockFinish = 0;
   let blockParams;
+  /** @type {EndBlockStats | undefined} */
+  let endBlockStats;
+  let inboundProcessed = 0;
   let decohered;
   let afterCommitWorkDone = Promise.resolve();
 
@@ -591,6 +660,52 @@ export async function launch({
         break;
       }
 
+      case ActionType.UPDATE_POWER_FLAGS: {
+        // The provisioning handlers only know how to provision, so ask them
+        // to provision a smart wallet if that power flag was just added.
+        const { address, addedPowerFlags, powerFlags, submitter } = action;
+        if (addedPowerFlags.includes('SMART_WALLET')) {
+          p = doBridgeInbound(
+            BRIDGE_ID.PROVISION,
+            {
+              type: ActionType.PLEASE_PROVISION,
+              nickname: '',
+              address,
+              powerFlags,
+              submitter,
+              autoProvision: false,
+              blockHeight: action.blockHeight,
+              blockTime: action.blockTime,
+            },
+            inboundNum,
+          );
+        } else {
+          // Nothing to provision for the other power flags, nor for removed
+          // ones, which only gate future provisioning by the chain.
+          controller.writeSlogObject({
+            type: 'cosmic-swingset-update-power-flags',
+            inboundNum,
+            address,
+            powerFlags,
+          });
+        }
+        break;
+      }
+
+      case ActionType.PLEASE_DEPROVISION: {
+        // The chain removed the egress, and the vats have no notion of
+        // deprovisioning, so just keep a trace of it.
+        const { address, reason } = action;
+        controller.writeSlogObject({
+          type: 'cosmic-swingset-deprovision',
+          inboundNum,
+          address,
+          reason,
+        });
+        blockManagerConsole.info(`deprovisioned ${address}: ${reason}`);
+        break;
+      }
+
       case ActionType.INSTALL_BUNDLE: {
         p = installBundle(action.bundle);
         break;
@@ -611,6 +726,57 @@ export async function launch({
         break;
       }
 
+      case ActionType.WALLET_ACTION_BATCH: {
+        // The wallet bridge only knows of single actions, so deliver those of
+        // the batch in order, as if they had been queued separately.
+        const { owner, actions, blockHeight, blockTime } = action;
+        p = (async () => {
+          for (const [i, entry] of actions.entries()) {
+            const walletAction =
+              entry.spendAction === undefined
+                ? {
+                    type: ActionType.WALLET_ACTION,
+                    owner,
+                    action: entry.action,
+                    blockHeight,
+                    blockTime,
+                  }
+                : {
+                    type: ActionType.WALLET_SPEND_ACTION,
+                    owner,
+                    spendAction: entry.spendAction,
+                    blockHeight,
+                    blockTime,
+                  };
+            await doBridgeInbound(
+              BRIDGE_ID.WALLET,
+              walletAction,
+              `${inboundNum}-${i}`,
+            );
+          }
+        })();
+        break;
+      }
+
+      case ActionType.INBOUND_QUEUE_PURGED: {
+        // Governance removed records from an inbound queue, which the chain
+        // has archived in vstorage. Nothing is left for the kernel to do, but
+        // keep a trace of the purge in the slog.
+        const { queue, purged, archiveStart, archiveEnd } = action;
+        controller.writeSlogObject({
+          type: 'cosmic-swingset-inbound-queue-purged',
+          inboundNum,
+          queue,
+          purged,
+          archiveStart,
+          archiveEnd,
+        });
+        blockManagerConsole.info(
+          `${purged.length} records purged from ${queue}, archived at [${archiveStart}, ${archiveEnd})`,
+        );
+        break;
+      }
+
       default: {
         Fail`${action.type} not recognized`;
       }
@@ -631,6 +797,7 @@ export async function launch({
     for await (const { action, context } of inboundQueue.consumeAll()) {
       const inboundNum = `${context.blockHeight}-${context.txHash}-${context.msgIdx}`;
       inboundQueueMetrics.decStat();
+      inboundProcessed += 1;
       await performAction(action, inboundNum);
       keepGoing = await runSwingset();
       if (!keepGoing) {
@@ -694,13 +861,28 @@ export async function launch({
     const runPolicy = computronCounter(params.beansPerUnit, neverStop);
     const runSwingset = makeRunSwingset(blockHeight, runPolicy);
 
+    inboundProcessed = 0;
     await runKernel(runSwingset, blockHeight, blockTime);
 
+    // The block stopped early if inbound work is left for the next one.
+    const inboundRemaining =
+      actionQueue.size() + highPriorityQueue.size() + runThisBlock.size();
+
+    /** @type {EndBlockStats} */
+    const stats = harden({
+      computrons: `${runPolicy.totalComputrons()}`,
+      beansUsed: `${runPolicy.totalBeans()}`,
+      cranks: runPolicy.cranks(),
+      inboundProcessed,
+      stoppedEarly: inboundRemaining > 0,
+    });
+
     if (END_BLOCK_SPIN_MS) {
       // Introduce a busy-wait to artificially put load on the chain.
       const startTime = Date.now();
       while (Date.now() - startTime < END_BLOCK_SPIN_MS);
     }
+    return stats;
   }
 
   /**
@@ -1030,6 +1212,9 @@ export async function launch({
             decohered = e;
             throw e;
           }
+          // Reply with the stats saved when the block was executed.
+          const savedStats = kvStore.get(getHostKey('endBlockStats'));
+          endBlockStats = savedStats ? JSON.parse(savedStats) : undefined;
         } else {
           if (blockHeight !== savedBeginHeight) {
             decohered = Error(
@@ -1043,7 +1228,7 @@ export async function launch({
 
           provideInstallationPublisher();
 
-          await processAction(action.type, async () =>
+          endBlockStats = await processAction(action.type, async () =>
             endBlock(blockHeight, blockTime, blockParams),
           );
 
@@ -1064,7 +1249,7 @@ export async function launch({
 
         endBlockFinish = Date.now();
 
-        return undefined;
+        return endBlockStats;
       }
 
       default: {
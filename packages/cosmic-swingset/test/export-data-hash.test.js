@@ -0,0 +1,29 @@
+// @ts-check
+import test from 'ava';
+import {
+  goJsonStringify,
+  hashExportDataEntries,
+} from '../src/helpers/export-data-hash.js';
+
+test('goJsonStringify escapes like Go encoding/json', t => {
+  t.is(
+    goJsonStringify(['a<b>&c', 'line\u2028paragraph\u2029']),
+    String.raw`["a\u003cb\u003e\u0026c","line\u2028paragraph\u2029"]`,
+  );
+});
+
+// The same entries and hash are checked by TestSwingStoreExportDataHash in
+// golang/cosmos/x/swingset/genesis_test.go.
+test('hashExportDataEntries matches SwingStoreExportDataHash', t => {
+  const hash = hashExportDataEntries([
+    // Sorts after U+FF61 in UTF-8 but before it in UTF-16.
+    ['\u{1F600}', 'astral'],
+    ['\uFF61', 'halfwidth'],
+    ['a<b>&c', 'line\u2028paragraph\u2029'],
+    ['a', String.raw`{"quote":"\"","backslash":"\\"}`],
+  ]);
+  t.is(
+    hash,
+    'sha256:751f1e93200eccb9e135779e789dfb6bfc2b1f78bb47c045c32173fc7d3e8b06',
+  );
+});
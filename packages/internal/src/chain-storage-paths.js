@@ -14,3 +14,4 @@ export const MAILBOX = 'mailbox';
 export const BUNDLES = 'bundles';
 export const CUSTOM = 'published';
 export const SWING_STORE = 'swingStore';
+export const PURGED_ACTIONS = 'purgedActions';
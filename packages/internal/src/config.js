@@ -21,6 +21,7 @@ export const BridgeId = /** @type {const} */ ({
   CORE: 'core',
   DIBC: 'dibc',
   STORAGE: 'storage',
+  SWINGSET: 'swingset',
   PROVISION: 'provision',
   PROVISION_SMART_WALLET: 'provisionWallet',
   VLOCALCHAIN: 'vlocalchain',
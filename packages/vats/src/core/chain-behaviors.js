@@ -55,14 +55,49 @@ export const bridgeCoreEval = async allPowers => {
   };
   harden(evaluateBundleCap);
 
+  /**
+   * The bridge to the swingset module of the chain, if running with a bridge.
+   *
+   * @type {import('@endo/promise-kit').PromiseKit<
+   *   import('../types.js').ScopedBridgeManager<'swingset'> | undefined
+   * >}
+   */
+  const swingsetBridgeKit = makePromiseKit();
+
+  /**
+   * Report the outcome of a governance MsgCoreEval to the chain, so that it
+   * can be queried by proposal.
+   *
+   * @param {number} coreEvalId
+   * @param {Promise<unknown>} evalsP
+   */
+  const reportCoreEvalOutcome = async (coreEvalId, evalsP) => {
+    const outcome = await evalsP.then(
+      () => ({ coreEvalId, success: true, error: '' }),
+      err => ({ coreEvalId, success: false, error: `${err}` }),
+    );
+    const swingsetBridge = await swingsetBridgeKit.promise;
+    if (!swingsetBridge) {
+      return;
+    }
+    await E(swingsetBridge)
+      .toBridge({
+        method: 'coreEvalOutcome',
+        args: [outcome],
+      })
+      .catch(e =>
+        console.error(`Error reporting core eval ${coreEvalId} outcome:`, e),
+      );
+  };
+
   // Register a coreEval handler over the bridge.
   const handler = Far('coreHandler', {
     async fromBridge(obj) {
       switch (obj.type) {
         case 'CORE_EVAL': {
-          /** @type {import('@agoric/cosmic-proto/swingset/swingset.js').CoreEvalProposalSDKType} */
-          const { evals } = obj;
-          return Promise.all(
+          /** @type {import('@agoric/cosmic-proto/swingset/swingset.js').CoreEvalProposalSDKType & { coreEvalId?: number }} */
+          const { evals, coreEvalId } = obj;
+          const evalsP = Promise.all(
             evals.map(({ json_permits: jsonPermit, js_code: code }) =>
               // Run in a new turn to avoid crosstalk of the evaluations.
               Promise.resolve()
@@ -91,7 +126,12 @@ export const bridgeCoreEval = async allPowers => {
                   throw err;
                 }),
             ),
-          ).then(_ => {});
+          );
+          if (coreEvalId !== undefined) {
+            // Only MsgCoreEvals carry an ID, legacy proposals have no outcome.
+            void reportCoreEvalOutcome(coreEvalId, evalsP);
+          }
+          return evalsP.then(_ => {});
         }
         default: {
           throw Fail`Unrecognized request ${obj.type}`;
@@ -104,8 +144,12 @@ export const bridgeCoreEval = async allPowers => {
   const bridgeManager = await bridgeManagerP;
   if (!bridgeManager) {
     // Not running with a bridge.
+    swingsetBridgeKit.resolve(undefined);
     return;
   }
+  swingsetBridgeKit.resolve(
+    makeScopedBridge(bridgeManager, BRIDGE_ID.SWINGSET),
+  );
   await makeScopedBridge(bridgeManager, BRIDGE_ID.CORE, handler);
 };
 harden(bridgeCoreEval);